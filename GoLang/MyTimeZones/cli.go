@@ -0,0 +1,428 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/yourusername/MyTimeZones/pkg/config"
+	"github.com/yourusername/MyTimeZones/pkg/export"
+	"github.com/yourusername/MyTimeZones/pkg/ical"
+	"github.com/yourusername/MyTimeZones/pkg/importer"
+	"github.com/yourusername/MyTimeZones/pkg/logger"
+	"github.com/yourusername/MyTimeZones/pkg/server"
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// cliCommands are the subcommands that run without starting the GUI
+var cliCommands = map[string]func(args []string, stdout, stderr io.Writer) int{
+	"ics":      runICS,
+	"import":   runImport,
+	"list":     runList,
+	"serve":    runServe,
+	"validate": runValidate,
+}
+
+// isHeadless reports whether the arguments ask for a CLI command instead of the GUI
+func isHeadless(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	if _, ok := cliCommands[args[0]]; ok {
+		return true
+	}
+	for _, arg := range args {
+		if arg == "--headless" || arg == "-headless" {
+			return true
+		}
+	}
+	return false
+}
+
+// runCLI dispatches to a subcommand, "list" is the default
+func runCLI(args []string, stdout, stderr io.Writer) int {
+	command := "list"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command, args = args[0], args[1:]
+	}
+	run, ok := cliCommands[command]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command %q\n", command)
+		return 2
+	}
+	return run(args, stdout, stderr)
+}
+
+// addConfigFlags registers --config and --portable and returns a function
+// loading the config they point at. Unlike the GUI, the commands do not
+// create a missing config, so a mistyped --config fails.
+func addConfigFlags(flags *flag.FlagSet) func() (*config.AppConfig, error) {
+	configPath := flags.String("config", "", "path to config.json (default: $"+config.EnvPath+", portable or user config directory)")
+	portable := flags.Bool("portable", false, "keep config.json next to the binary")
+	return func() (*config.AppConfig, error) {
+		path, err := config.ResolvePath(*configPath, *portable)
+		if err != nil {
+			return nil, err
+		}
+		return config.LoadConfig(path)
+	}
+}
+
+func runList(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("list", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	loadConfig := addConfigFlags(flags)
+	at := flags.String("at", "", `reference time in the Local zone, e.g. "2025-03-30 16:00", or RFC 3339`)
+	zones := flags.String("zones", "", "comma separated zone names or descriptions to show")
+	format := flags.String("format", "table", "output format: table, json, csv, tsv or markdown")
+	flags.Bool("headless", true, "print the zone table instead of starting the GUI")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	var outFormat export.Format
+	if *format != "table" {
+		f, err := export.ParseFormat(*format)
+		if err != nil {
+			fmt.Fprintf(stderr, "invalid --format: %v\n", err)
+			return 2
+		}
+		outFormat = f
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to load config: %v\n", err)
+		return 1
+	}
+
+	timeManager, err := newTimeManager(cfg)
+	if err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return 1
+	}
+
+	now := time.Now()
+	if *at != "" {
+		now, err = timezone.ParseTimeIn(*at, cfg.TimeZones.Local.Zone)
+		if err != nil {
+			fmt.Fprintf(stderr, "invalid --at: %v\n", err)
+			return 2
+		}
+	}
+
+	timeInfo, err := timeManager.GetTimeInfoAt(now)
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to get time info: %v\n", err)
+		return 1
+	}
+
+	timeInfo = filterTimeInfo(timeInfo, *zones)
+	if outFormat == "" {
+		printTimeTable(stdout, timeInfo, cfg.ShowSeconds)
+		return 0
+	}
+	if err := export.Write(stdout, outFormat, timeInfo); err != nil {
+		fmt.Fprintf(stderr, "failed to write output: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// newTimeManager creates a manager for the zones and holidays of cfg
+func newTimeManager(cfg *config.AppConfig) (*timezone.Manager, error) {
+	timeManager, err := timezone.NewManagerFromConfig(cfg.TimeZones)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone configuration: %w", err)
+	}
+	holidays, err := cfg.LoadHolidays()
+	if err != nil {
+		return nil, err
+	}
+	timeManager.SetHolidays(holidays)
+	return timeManager, nil
+}
+
+// filterTimeInfo keeps the rows whose zone or description matches one of
+// the comma separated filters, case insensitively
+func filterTimeInfo(timeInfo []timezone.TimeInfo, filter string) []timezone.TimeInfo {
+	var wanted []string
+	for _, f := range strings.Split(filter, ",") {
+		if f = strings.ToLower(strings.TrimSpace(f)); f != "" {
+			wanted = append(wanted, f)
+		}
+	}
+	if len(wanted) == 0 {
+		return timeInfo
+	}
+
+	var filtered []timezone.TimeInfo
+	for _, info := range timeInfo {
+		name := strings.ToLower(info.Name)
+		desc := strings.ToLower(info.Description)
+		for _, f := range wanted {
+			if strings.Contains(name, f) || strings.Contains(desc, f) {
+				filtered = append(filtered, info)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func printTimeTable(out io.Writer, timeInfo []timezone.TimeInfo, showSeconds bool) {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tDESCRIPTION\tDATE\tTIME\tDIFF\tSTATUS\tHOLIDAY\tNEXT CHANGE")
+	for _, info := range timeInfo {
+		clock := info.Time
+		if !showSeconds && len(clock) > 5 {
+			clock = clock[:5]
+		}
+		next := ""
+		if info.Transition != nil {
+			next = info.Transition.String()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			info.Name, info.Description, info.Date, clock, info.Diff, info.Status, info.Holiday, next)
+	}
+	tw.Flush()
+}
+
+// runServe runs the HTTP/JSON API without the GUI until interrupted
+func runServe(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	loadConfig := addConfigFlags(flags)
+	listen := flags.String("listen", "", "address to listen on (default from config)")
+	flags.Bool("headless", true, "run without starting the GUI")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to load config: %v\n", err)
+		return 1
+	}
+
+	timeManager, err := newTimeManager(cfg)
+	if err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return 1
+	}
+	defer timeManager.Close()
+
+	address := cfg.Server.Address
+	if *listen != "" {
+		address = *listen
+	}
+	apiServer := server.NewServer(address, timeManager, logger.NewLogger("info"))
+	if err := apiServer.Start(); err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return 1
+	}
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
+	if err := apiServer.Close(); err != nil {
+		fmt.Fprintf(stderr, "failed to stop API server: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// runValidate prints every problem in the config, exiting 1 on errors
+func runValidate(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("validate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	loadConfig := addConfigFlags(flags)
+	flags.Bool("headless", true, "run without starting the GUI")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to load config: %v\n", err)
+		return 1
+	}
+
+	issues := cfg.Validate()
+	for _, issue := range issues {
+		fmt.Fprintln(stdout, issue)
+	}
+	if issues.HasErrors() {
+		return 1
+	}
+	fmt.Fprintf(stdout, "%s: %d warning(s), no errors\n", cfg.Path(), len(issues))
+	return 0
+}
+
+// runImport previews merging CSV and vCard rosters into the configured
+// people, and saves the result with --apply
+func runImport(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	loadConfig := addConfigFlags(flags)
+	apply := flags.Bool("apply", false, "save the merged people to the config instead of only previewing")
+	offsetZones := offsetZonesFlag{}
+	flags.Var(offsetZones, "offset-zone", "zone for people written with a shared UTC offset, e.g. +01:00=Europe/Berlin (repeatable)")
+	flags.Bool("headless", true, "run without starting the GUI")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		fmt.Fprintln(stderr, "usage: import [--apply] [--offset-zone offset=zone]... file.csv|file.vcf...")
+		return 2
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to load config: %v\n", err)
+		return 1
+	}
+
+	result, err := importer.ParseFiles(flags.Args())
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to import: %v\n", err)
+		return 1
+	}
+	result.Pick(offsetZones)
+	for _, problem := range result.Problems {
+		fmt.Fprintf(stderr, "skipped %s\n", problem)
+	}
+	for _, choice := range result.Choices {
+		key, _ := importer.OffsetKey(choice.Offset)
+		fmt.Fprintf(stderr, "need a zone: %s, pass --offset-zone %s=ZONE\n", choice, key)
+	}
+
+	changes := importer.Diff(cfg.TimeZones.People, result.People)
+	for _, change := range changes {
+		fmt.Fprintln(stdout, change)
+	}
+	counts := importer.Counts(changes)
+	fmt.Fprintf(stdout, "%d to add, %d to update, %d unchanged, %d need a zone, %d skipped\n",
+		counts[importer.Add], counts[importer.Update], counts[importer.Unchanged], len(result.Choices), len(result.Problems))
+
+	if !*apply {
+		return 0
+	}
+	cfg.TimeZones.People = importer.Merge(cfg.TimeZones.People, changes)
+	if err := cfg.TimeZones.Validate().Err(); err != nil {
+		fmt.Fprintf(stderr, "not saved, the merged config is invalid: %v\n", err)
+		return 1
+	}
+	if err := cfg.Save(cfg.Path()); err != nil {
+		fmt.Fprintf(stderr, "failed to save config: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(stdout, "saved %s\n", cfg.Path())
+	return 0
+}
+
+// offsetZonesFlag collects --offset-zone offset=zone pairs, keyed by
+// importer.OffsetKey
+type offsetZonesFlag map[string]string
+
+func (f offsetZonesFlag) String() string {
+	var pairs []string
+	for offset, zone := range f {
+		pairs = append(pairs, offset+"="+zone)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (f offsetZonesFlag) Set(value string) error {
+	offset, zone, found := strings.Cut(value, "=")
+	if !found {
+		return fmt.Errorf("want offset=zone, e.g. +01:00=Europe/Berlin")
+	}
+	key, ok := importer.OffsetKey(offset)
+	if !ok {
+		return fmt.Errorf("%q is not a UTC offset", offset)
+	}
+	zone, err := importer.ResolveZone(zone)
+	if err != nil {
+		return err
+	}
+	f[key] = zone
+	return nil
+}
+
+// runICS writes an iCalendar event for a meeting, listing the local time of
+// every configured zone in its description
+func runICS(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("ics", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	loadConfig := addConfigFlags(flags)
+	at := flags.String("at", "", `meeting start in --zone, e.g. "2025-03-30 16:00", or RFC 3339`)
+	zone := flags.String("zone", "", "zone of --at and of the event (default: the Local zone)")
+	duration := flags.Duration("duration", time.Hour, "meeting length")
+	summary := flags.String("summary", "Meeting", "event title")
+	outPath := flags.String("out", "", "file to write (default: standard output)")
+	flags.Bool("headless", true, "run without starting the GUI")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *at == "" {
+		fmt.Fprintln(stderr, "--at is required")
+		return 2
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to load config: %v\n", err)
+		return 1
+	}
+	timeManager, err := newTimeManager(cfg)
+	if err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return 1
+	}
+
+	if *zone == "" {
+		*zone = cfg.TimeZones.Local.Zone
+	}
+	start, err := timezone.ParseTimeIn(*at, *zone)
+	if err != nil {
+		fmt.Fprintf(stderr, "invalid --at: %v\n", err)
+		return 2
+	}
+	loc, _ := time.LoadLocation(*zone)
+	event, err := ical.NewMeeting(timeManager, *summary, start.In(loc), *duration)
+	if err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return 2
+	}
+
+	if *outPath == "" {
+		if err := ical.Write(stdout, event); err != nil {
+			fmt.Fprintf(stderr, "failed to write output: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+	file, err := os.Create(*outPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return 1
+	}
+	if err := ical.Write(file, event); err != nil {
+		file.Close()
+		fmt.Fprintf(stderr, "failed to write %s: %v\n", *outPath, err)
+		return 1
+	}
+	if err := file.Close(); err != nil {
+		fmt.Fprintf(stderr, "failed to write %s: %v\n", *outPath, err)
+		return 1
+	}
+	fmt.Fprintf(stdout, "wrote %s\n", *outPath)
+	return 0
+}
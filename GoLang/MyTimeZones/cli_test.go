@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bytes"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testConfig = `{
+  "version": 2,
+  "timeZones": {
+    "local": {"zone": "Europe/Lisbon", "description": "Lisbon"},
+    "others": [
+      {"zone": "America/New_York", "description": "New York"},
+      {"zone": "Asia/Tokyo", "description": "Tokyo"}
+    ]
+  }
+}`
+
+// writeTestConfig writes a config file to a temporary directory
+func writeTestConfig(t *testing.T, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+func TestRunCLI(t *testing.T) {
+	path := writeTestConfig(t, testConfig)
+	unknownZone := writeTestConfig(t, strings.Replace(testConfig, "Asia/Tokyo", "Asia/Atlantis", 1))
+	missing := filepath.Join(t.TempDir(), "missing.json")
+	roster := filepath.Join("pkg", "importer", "testdata", "team.csv")
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantOut    []string
+		notOut     []string
+		wantStderr string
+	}{
+		{
+			name:     "list prints every zone",
+			args:     []string{"list", "--config", path, "--at", "2025-06-11 12:00"},
+			wantOut:  []string{"NAME", "Europe/Lisbon", "America/New_York", "Asia/Tokyo", "2025-06-11", "12:00"},
+			wantCode: 0,
+		},
+		{
+			name:     "list is the default command",
+			args:     []string{"--config", path, "--headless"},
+			wantOut:  []string{"Europe/Lisbon"},
+			wantCode: 0,
+		},
+		{
+			name:     "zones filter by name and description",
+			args:     []string{"list", "--config", path, "--zones", "tokyo, new york"},
+			wantOut:  []string{"Asia/Tokyo", "America/New_York"},
+			notOut:   []string{"Europe/Lisbon"},
+			wantCode: 0,
+		},
+		{
+			name:     "json format",
+			args:     []string{"list", "--config", path, "--format", "json", "--zones", "Tokyo"},
+			wantOut:  []string{`"zone": "Asia/Tokyo"`},
+			wantCode: 0,
+		},
+		{
+			name:       "invalid format",
+			args:       []string{"list", "--config", path, "--format", "xml"},
+			wantCode:   2,
+			wantStderr: "invalid --format",
+		},
+		{
+			name:       "unknown zone",
+			args:       []string{"list", "--config", unknownZone},
+			wantCode:   1,
+			wantStderr: "Asia/Atlantis",
+		},
+		{
+			name:       "missing config",
+			args:       []string{"list", "--config", missing},
+			wantCode:   1,
+			wantStderr: "failed to load config",
+		},
+		{
+			name:       "unknown command",
+			args:       []string{"lsit"},
+			wantCode:   2,
+			wantStderr: `unknown command "lsit"`,
+		},
+		{
+			name:     "validate a good config",
+			args:     []string{"validate", "--config", path},
+			wantOut:  []string{"0 warning(s), no errors"},
+			wantCode: 0,
+		},
+		{
+			name:     "validate reports the unknown zone",
+			args:     []string{"validate", "--config", unknownZone},
+			wantOut:  []string{"Asia/Atlantis"},
+			wantCode: 1,
+		},
+		{
+			name:       "import previews without saving",
+			args:       []string{"import", "--config", path, roster},
+			wantOut:    []string{"2 to add", "2 need a zone, 1 skipped"},
+			wantCode:   0,
+			wantStderr: "pass --offset-zone +08:00=ZONE",
+		},
+		{
+			name:     "import maps shared offsets to the given zones",
+			args:     []string{"import", "--config", path, "--offset-zone", "+05:30=Asia/Kolkata", "--offset-zone", "UTC+8=Singapore", roster},
+			wantOut:  []string{"Raj Patel <raj@example.com> Asia/Kolkata", "Li Wei <li@example.com> Asia/Singapore", "4 to add", "0 need a zone, 1 skipped"},
+			wantCode: 0,
+		},
+		{
+			name:       "import rejects a malformed offset zone",
+			args:       []string{"import", "--config", path, "--offset-zone", "Europe/Berlin", roster},
+			wantCode:   2,
+			wantStderr: "want offset=zone",
+		},
+		{
+			name:       "import needs a file",
+			args:       []string{"import", "--config", path},
+			wantCode:   2,
+			wantStderr: "usage: import",
+		},
+		{
+			name:     "ics writes an event",
+			args:     []string{"ics", "--config", path, "--at", "2025-06-11 15:00", "--summary", "Sync"},
+			wantOut:  []string{"BEGIN:VCALENDAR", "SUMMARY:Sync", "TZID=Europe/Lisbon"},
+			wantCode: 0,
+		},
+		{
+			name:       "ics needs --at",
+			args:       []string{"ics", "--config", path},
+			wantCode:   2,
+			wantStderr: "--at is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if code := runCLI(tt.args, &stdout, &stderr); code != tt.wantCode {
+				t.Errorf("runCLI(%q) = %d, want %d; stderr: %s", tt.args, code, tt.wantCode, stderr.String())
+			}
+			for _, want := range tt.wantOut {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("output is missing %q:\n%s", want, stdout.String())
+				}
+			}
+			for _, unwanted := range tt.notOut {
+				if strings.Contains(stdout.String(), unwanted) {
+					t.Errorf("output contains %q:\n%s", unwanted, stdout.String())
+				}
+			}
+			if !strings.Contains(stderr.String(), tt.wantStderr) {
+				t.Errorf("stderr = %q, want it to contain %q", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+
+	if _, err := os.Stat(missing); !os.IsNotExist(err) {
+		t.Errorf("list created the missing config %s", missing)
+	}
+}
+
+func TestRunServe_AddressInUse(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	defer listener.Close()
+
+	var stdout, stderr bytes.Buffer
+	path := writeTestConfig(t, testConfig)
+	if code := runCLI([]string{"serve", "--config", path, "--listen", listener.Addr().String()}, &stdout, &stderr); code != 1 {
+		t.Errorf("serve on a used address = %d, want 1", code)
+	}
+	if !strings.Contains(stderr.String(), "failed to listen") {
+		t.Errorf("stderr = %q, want a listen error", stderr.String())
+	}
+}
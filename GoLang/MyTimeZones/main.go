@@ -1,41 +1,80 @@
 package main
 
 import (
+	"flag"
 	"os"
 
 	"fyne.io/fyne/v2/app"
 
 	"github.com/yourusername/MyTimeZones/pkg/config"
 	"github.com/yourusername/MyTimeZones/pkg/logger"
+	"github.com/yourusername/MyTimeZones/pkg/server"
 	"github.com/yourusername/MyTimeZones/pkg/timezone"
 	"github.com/yourusername/MyTimeZones/pkg/ui"
 )
 
 func main() {
+	if isHeadless(os.Args[1:]) {
+		os.Exit(runCLI(os.Args[1:], os.Stdout, os.Stderr))
+	}
+
+	configPath := flag.String("config", "", "path to config.json (default: $"+config.EnvPath+", portable or user config directory)")
+	portable := flag.Bool("portable", false, "keep config.json next to the binary")
+	serve := flag.Bool("serve", false, "start the local HTTP/JSON API beside the window")
+	listen := flag.String("listen", "", "address for the HTTP/JSON API (default from config)")
+	flag.Parse()
+
 	log := logger.NewLogger("info")
 
-	cfg, err := config.LoadOrCreateConfig("config.json")
+	path, err := config.ResolvePath(*configPath, *portable)
+	if err != nil {
+		log.Error("Failed to locate config: %v", err)
+		os.Exit(1)
+	}
+	log.Info("Using config %s", path)
+
+	cfg, err := config.LoadOrCreateConfig(path)
 	if err != nil {
 		log.Error("Failed to load config: %v", err)
 		os.Exit(1)
 	}
 
-	refreshRate := cfg.RefreshRateSeconds
-	if !cfg.ShowSeconds && refreshRate < 60 {
-		refreshRate = 60
+	// Problems are shown in the window, the config can be fixed while it runs
+	issues := cfg.Validate()
+	for _, issue := range issues {
+		log.Error("Config %s", issue)
 	}
 
-	timeManager, err := timezone.NewManager("config.json")
-	if err != nil {
-		log.Error("Failed to initialize timezone manager: %v", err)
-		os.Exit(1)
+	timeManager := timezone.NewUncheckedManager(cfg.TimeZones)
+	if holidays, err := cfg.LoadHolidays(); err != nil {
+		log.Error("%v", err)
+	} else {
+		timeManager.SetHolidays(holidays)
 	}
 
-	// Ensure manager is in sync with config
-	_ = timeManager.UpdateConfig(cfg.TimeZones)
+	if *serve || cfg.Server.Enabled {
+		address := cfg.Server.Address
+		if *listen != "" {
+			address = *listen
+		}
+		apiServer := server.NewServer(address, timeManager, log)
+		if err := apiServer.Start(); err != nil {
+			log.Error("Failed to start API server: %v", err)
+		} else {
+			defer apiServer.Close()
+		}
+	}
 
 	myApp := app.New()
-	window := ui.NewWindow(myApp, cfg, timeManager, log, refreshRate, cfg.ShowSeconds)
+	window := ui.NewWindow(myApp, cfg, timeManager, log, cfg.RefreshRate(), cfg.ShowSeconds)
 	window.Show()
+	window.ReportIssues(issues)
+
+	if watcher, err := config.WatchConfig(path, window.ReloadConfig); err != nil {
+		log.Error("Failed to watch config: %v", err)
+	} else {
+		defer watcher.Close()
+	}
+
 	myApp.Run()
 }
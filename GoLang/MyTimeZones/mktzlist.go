@@ -1,86 +1,296 @@
 //go:build ignore
 // +build ignore
 
-// mktzlist.go is a code generator that produces a file containing all time zones.
-// It reads the zoneinfo.zip from $GOROOT/lib/time/zoneinfo.zip.
+// mktzlist.go is a code generator that produces a file containing all time
+// zones and their metadata. The names come from $GOROOT/lib/time/zoneinfo.zip,
+// the metadata from the tzdata tables of -tzdata: zone1970.tab and zone.tab
+// for countries, coordinates and comments, iso3166.tab for country names and
+// tzdata.zi for the links between zones.
+//
+// Run it with go generate in pkg/timezone.
 
 package main
 
 import (
 	"archive/zip"
+	"bufio"
 	"bytes"
+	"flag"
 	"fmt"
+	"go/format"
 	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 )
 
+// zoneRecord is one line of zone1970.tab or zone.tab
+type zoneRecord struct {
+	countries []string
+	lat, lon  float64
+	comment   string
+}
+
 func main() {
-	// Get the GOROOT path.
-	b, err := exec.Command("go", "env", "GOROOT").Output()
+	tzdata := flag.String("tzdata", "/usr/share/zoneinfo", "directory holding zone1970.tab, zone.tab, iso3166.tab and tzdata.zi")
+	output := flag.String("o", "zones_gen.go", "output file")
+	flag.Parse()
+
+	names, err := zipNames()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "error getting GOROOT: %v\n", err)
-		os.Exit(1)
+		fatal(err)
 	}
-	goroot := string(bytes.TrimSpace(b))
 
-	// Open the zoneinfo.zip file.
-	zipname := filepath.Join(goroot, "lib", "time", "zoneinfo.zip")
-	zipf, err := os.Open(zipname)
+	countries, err := readCountries(filepath.Join(*tzdata, "iso3166.tab"))
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "error opening %s: %v\n", zipname, err)
-		os.Exit(1)
+		fatal(err)
 	}
-	defer zipf.Close()
 
-	zipfi, err := zipf.Stat()
+	// zone1970.tab lists every country sharing a zone, zone.tab adds the
+	// zones kept for a single country
+	zones, err := readZones(filepath.Join(*tzdata, "zone1970.tab"))
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "error stating %s: %v\n", zipname, err)
-		os.Exit(1)
+		fatal(err)
 	}
-
-	// Create the output file.
-	outFile := os.Getenv("GOFILE")
-	if outFile == "" {
-		outFile = "tzlist_gen.go"
+	perCountry, err := readZones(filepath.Join(*tzdata, "zone.tab"))
+	if err != nil {
+		fatal(err)
 	}
-	f, err := os.Create(outFile)
+	for name, r := range perCountry {
+		if _, ok := zones[name]; !ok {
+			zones[name] = r
+		}
+	}
+
+	version, links, err := readLinks(filepath.Join(*tzdata, "tzdata.zi"))
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "error creating %s: %v\n", outFile, err)
-		os.Exit(1)
+		fatal(err)
 	}
-	defer f.Close()
 
-	// Write header information.
-	fmt.Fprintln(f, "// Code generated by \"mktzlist.go\"; DO NOT EDIT.")
-	fmt.Fprintln(f, "//go:generate go run mktzlist.go")
-	fmt.Fprintln(f)
-	// Use the same package as this file's GO package.
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by \"mktzlist.go\"; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	// Use the same package as the go:generate directive
 	pkg := os.Getenv("GOPACKAGE")
 	if pkg == "" {
 		pkg = "main"
 	}
-	fmt.Fprintf(f, "package %s\n\n", pkg)
-	fmt.Fprintln(f, "var TimeZones = []string{")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+
+	fmt.Fprintf(&b, "// tzdataVersion is the release of the tables the metadata comes from\n")
+	fmt.Fprintf(&b, "const tzdataVersion = %q\n\n", version)
+
+	fmt.Fprintln(&b, "var timeZoneNames = []string{")
+	for _, name := range names {
+		fmt.Fprintf(&b, "%q,\n", name)
+	}
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b)
+
+	fmt.Fprintln(&b, "var countryNames = map[string]string{")
+	for _, code := range sortedKeys(countries) {
+		fmt.Fprintf(&b, "%q: %q,\n", code, countries[code])
+	}
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b)
 
-	// Open the zip file as a reader.
-	zr, err := zip.NewReader(zipf, zipfi.Size())
+	fmt.Fprintln(&b, "var zoneMetadata = map[string]ZoneInfo{")
+	for _, name := range sortedKeys(zones) {
+		r := zones[name]
+		fmt.Fprintf(&b, "%q: {Countries: %#v, Coordinates: &Coordinates{%s, %s}", name, r.countries,
+			strconv.FormatFloat(r.lat, 'f', -1, 64), strconv.FormatFloat(r.lon, 'f', -1, 64))
+		if r.comment != "" {
+			fmt.Fprintf(&b, ", Comment: %q", r.comment)
+		}
+		fmt.Fprintln(&b, "},")
+	}
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b)
+
+	fmt.Fprintln(&b, "var zoneLinks = map[string]string{")
+	for _, name := range sortedKeys(links) {
+		fmt.Fprintf(&b, "%q: %q,\n", name, links[name])
+	}
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		fatal(fmt.Errorf("formatting generated code: %v", err))
+	}
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		fatal(err)
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "mktzlist: %v\n", err)
+	os.Exit(1)
+}
+
+// zipNames returns every zone of Go's embedded zoneinfo.zip, sorted
+func zipNames() ([]string, error) {
+	b, err := exec.Command("go", "env", "GOROOT").Output()
+	if err != nil {
+		return nil, fmt.Errorf("error getting GOROOT: %v", err)
+	}
+	goroot := string(bytes.TrimSpace(b))
+
+	zipname := filepath.Join(goroot, "lib", "time", "zoneinfo.zip")
+	zr, err := zip.OpenReader(zipname)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "error reading zip: %v\n", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("error opening %s: %v", zipname, err)
 	}
+	defer zr.Close()
 
-	// Walk through the zip archive.
-	fs.WalkDir(zr, ".", func(path string, d fs.DirEntry, err error) error {
+	var names []string
+	err = fs.WalkDir(zr, ".", func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
 		if !d.IsDir() {
-			fmt.Fprintf(f, "\t%q,\n", path)
+			names = append(names, path)
 		}
 		return nil
 	})
+	sort.Strings(names)
+	return names, err
+}
+
+// readTable returns the tab separated fields of every line that is not a
+// comment
+func readTable(path string) ([][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var rows [][]string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rows = append(rows, strings.Split(line, "\t"))
+	}
+	return rows, scanner.Err()
+}
 
-	fmt.Fprintln(f, "}")
+func readCountries(path string) (map[string]string, error) {
+	rows, err := readTable(path)
+	if err != nil {
+		return nil, err
+	}
+	countries := make(map[string]string, len(rows))
+	for _, row := range rows {
+		if len(row) < 2 {
+			return nil, fmt.Errorf("%s: invalid line %q", path, strings.Join(row, "\t"))
+		}
+		countries[row[0]] = row[1]
+	}
+	return countries, nil
+}
+
+func readZones(path string) (map[string]zoneRecord, error) {
+	rows, err := readTable(path)
+	if err != nil {
+		return nil, err
+	}
+	zones := make(map[string]zoneRecord, len(rows))
+	for _, row := range rows {
+		if len(row) < 3 {
+			return nil, fmt.Errorf("%s: invalid line %q", path, strings.Join(row, "\t"))
+		}
+		lat, lon, err := parseCoordinates(row[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %v", path, row[2], err)
+		}
+		r := zoneRecord{countries: strings.Split(row[0], ","), lat: lat, lon: lon}
+		if len(row) > 3 {
+			r.comment = row[3]
+		}
+		zones[row[2]] = r
+	}
+	return zones, nil
+}
+
+// parseCoordinates parses ISO 6709 coordinates, ±DDMM±DDDMM or
+// ±DDMMSS±DDDMMSS, into degrees
+func parseCoordinates(s string) (float64, float64, error) {
+	split := strings.LastIndexAny(s, "+-")
+	if split <= 0 {
+		return 0, 0, fmt.Errorf("invalid coordinates %q", s)
+	}
+	lat, err := parseDegrees(s[:split], 2)
+	if err != nil {
+		return 0, 0, err
+	}
+	lon, err := parseDegrees(s[split:], 3)
+	return lat, lon, err
+}
+
+func parseDegrees(s string, degreeDigits int) (float64, error) {
+	sign := 1.0
+	if s[0] == '-' {
+		sign = -1
+	}
+	digits := s[1:]
+	if len(digits) != degreeDigits+2 && len(digits) != degreeDigits+4 {
+		return 0, fmt.Errorf("invalid coordinate %q", s)
+	}
+	value := 0.0
+	for n, unit := range []float64{1, 60, 3600} {
+		start := 0
+		size := degreeDigits
+		if n > 0 {
+			start, size = degreeDigits+2*(n-1), 2
+		}
+		if start >= len(digits) {
+			break
+		}
+		part, err := strconv.Atoi(digits[start : start+size])
+		if err != nil {
+			return 0, fmt.Errorf("invalid coordinate %q", s)
+		}
+		value += float64(part) / unit
+	}
+	// Five decimals is about a metre, more than the tables claim
+	value, _ = strconv.ParseFloat(strconv.FormatFloat(value, 'f', 5, 64), 64)
+	return sign * value, nil
+}
+
+// readLinks returns the version of tzdata.zi and its links, by link name
+func readLinks(path string) (string, map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+
+	version := ""
+	links := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		switch {
+		case len(fields) == 3 && fields[0] == "#" && fields[1] == "version":
+			version = fields[2]
+		case len(fields) == 3 && fields[0] == "L":
+			links[fields[2]] = fields[1]
+		}
+	}
+	return version, links, scanner.Err()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
@@ -0,0 +1,228 @@
+//go:build ignore
+// +build ignore
+
+// mkworldmap.go is a code generator that draws the equirectangular world map
+// embedded in the map view. The coastlines are simplified by hand to a few
+// points per degree of coast, enough to recognise the continents at the
+// size the map is shown; zone markers come from tzdata coordinates, not
+// from this drawing.
+//
+// Run it with go generate in pkg/ui.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"sort"
+)
+
+// point is a longitude and latitude in degrees
+type point struct{ lon, lat float64 }
+
+// land are the outlines of the continents and large islands
+var land = [][]point{
+	// North and Central America
+	{{-168, 66}, {-162, 70}, {-156, 71.3}, {-140, 69.6}, {-128, 70}, {-115, 68.5}, {-95, 72}, {-82, 73.5},
+		{-80, 69}, {-88, 64}, {-94, 59}, {-88, 56}, {-82, 52.5}, {-79, 54.5}, {-77, 60}, {-70, 61}, {-64, 60},
+		{-61, 56}, {-56, 52}, {-60, 47.5}, {-66, 44.5}, {-70, 41.5}, {-74, 40.5}, {-76, 35}, {-81, 31.5},
+		{-80, 25.5}, {-82, 28}, {-84, 30}, {-89, 30}, {-94, 29.5}, {-97.5, 27}, {-97.5, 22}, {-95, 18.5},
+		{-90.5, 19.5}, {-87, 21.5}, {-88, 16}, {-83.5, 15}, {-83.5, 11}, {-79.5, 9}, {-77.5, 8}, {-80, 7.5},
+		{-85.5, 10}, {-87.5, 13}, {-92, 14.5}, {-96, 15.7}, {-105, 19.5}, {-105.7, 22.5}, {-108, 25},
+		{-111, 28.5}, {-112.8, 31.3}, {-113, 29}, {-111, 25.5}, {-109.5, 23}, {-112, 24.7}, {-114, 27.5},
+		{-115.8, 30.5}, {-117, 32.5}, {-120.5, 34.5}, {-122.5, 37.5}, {-124.2, 41}, {-124, 46.5}, {-123, 48.5},
+		{-127, 50.5}, {-130.5, 54.5}, {-135, 58}, {-140, 59.8}, {-147, 61}, {-152, 59}, {-156, 57.5},
+		{-162, 55.5}, {-158, 58.5}, {-162, 60}, {-165, 62.5}, {-164, 64}, {-168, 65.5}},
+	// Greenland
+	{{-73, 78}, {-60, 82}, {-30, 83.5}, {-20, 81}, {-18, 76}, {-22, 70}, {-32, 68}, {-40, 65}, {-43, 60},
+		{-48, 61}, {-53, 66}, {-55, 70}, {-60, 76}},
+	// Arctic Canada
+	{{-80, 73}, {-70, 70}, {-62, 66.5}, {-66, 62}, {-72, 63}, {-78, 64.5}, {-86, 70}},
+	{{-120, 72}, {-105, 73.5}, {-90, 74.5}, {-80, 76}, {-75, 79}, {-65, 82}, {-90, 81.5}, {-110, 78},
+		{-120, 76}, {-125, 72}},
+	// Caribbean
+	{{-85, 21.9}, {-82, 23.2}, {-77, 22}, {-74.2, 20.2}, {-77.5, 19.9}, {-80, 21.8}, {-84, 21.8}},
+	{{-74.4, 18.4}, {-72.8, 19.9}, {-70, 19.7}, {-68.4, 18.6}, {-71, 18}},
+	// South America
+	{{-77.5, 8}, {-72, 12}, {-62, 10.5}, {-60, 8.5}, {-52, 5}, {-50, 0}, {-44, -2.5}, {-35, -5.5},
+		{-35, -9}, {-39, -14}, {-39, -18}, {-41, -22.5}, {-48, -25.5}, {-48.5, -28.5}, {-53, -33.5},
+		{-57, -35.5}, {-57, -38.5}, {-62, -39}, {-65, -42}, {-63.5, -43}, {-67.5, -46.5}, {-65.5, -48},
+		{-69, -51}, {-68.5, -53}, {-66, -55}, {-71, -55.5}, {-74.5, -52}, {-75.5, -47}, {-73.5, -43},
+		{-73.5, -37}, {-71.5, -30}, {-70.5, -23}, {-70, -18}, {-76, -14}, {-79.5, -7.5}, {-81, -5},
+		{-80, -1}, {-79, 1.5}, {-77.5, 4}},
+	// Eurasia, the inland seas are drawn as water below
+	{{-5.6, 36}, {-9, 37}, {-9.5, 39}, {-9, 43}, {-1.5, 43.4}, {-1.2, 46}, {-4.5, 47.8}, {-1.5, 48.7},
+		{1.5, 50.2}, {4, 51.5}, {5, 53.3}, {8.5, 53.8}, {8.2, 56.7}, {10.5, 57.6}, {10.5, 54.5}, {14, 54},
+		{19, 54.4}, {21.2, 55.5}, {21, 57.2}, {23.5, 59.2}, {28, 59.6}, {30, 60}, {23, 60.3}, {21.5, 61.5},
+		{21.5, 63.5}, {25, 65}, {22, 65.7}, {17.5, 62.5}, {18.8, 60}, {16.5, 56.5}, {12.7, 56}, {11, 58.8},
+		{8, 58}, {5.3, 59}, {5, 62}, {11, 64.5}, {14.5, 67.8}, {19, 70}, {26, 71}, {31, 70.3}, {33, 69.3},
+		{41, 67.5}, {40, 64.5}, {44, 66.5}, {44, 68.5}, {54, 68.7}, {60, 69.8}, {68, 68.5}, {73, 72.8},
+		{80, 73.5}, {87, 75}, {100, 77.5}, {107, 77}, {113, 73.6}, {128, 72.5}, {140, 72.5}, {150, 71.5},
+		{160, 70}, {170, 70}, {180, 69}, {180, 65}, {178, 64.5}, {177, 62.5}, {172, 61}, {164, 60},
+		{162, 57.8}, {156.7, 51}, {156, 57.5}, {163, 61.5}, {160, 61.7}, {155, 59.2}, {143, 59.3}, {137, 54},
+		{141, 52.5}, {140.5, 48.5}, {135.5, 43.5}, {131, 42.5}, {129.5, 41}, {129.5, 36}, {126.5, 34.5},
+		{126, 37.5}, {125, 39.6}, {121.5, 39}, {121.7, 40.8}, {118, 39}, {118, 38}, {122.5, 37}, {119.5, 35},
+		{121, 32}, {122, 30}, {120, 26}, {116.5, 23}, {110.5, 21.2}, {108.5, 21.6}, {106.5, 20},
+		{105.7, 18.8}, {108.8, 15}, {109, 11.5}, {105, 8.6}, {104.8, 10.5}, {103, 11}, {100, 13.5},
+		{100, 12.5}, {99, 10}, {100.5, 7.3}, {103.5, 4}, {104.2, 1.3}, {101.3, 2.8}, {98.5, 8}, {98.3, 12},
+		{97.7, 16.5}, {94.5, 16}, {94, 19}, {92.3, 21}, {91.8, 22.4}, {89, 21.8}, {86.9, 20.8}, {85, 19.2},
+		{80.3, 15.8}, {80.2, 13.2}, {79.8, 10.3}, {77.5, 8}, {76.3, 9.5}, {74.8, 12.8}, {73, 17}, {72.8, 21},
+		{70.5, 20.8}, {68.8, 22.8}, {66.6, 25.4}, {61.7, 25.2}, {57.3, 25.8}, {56.3, 27.2}, {54.5, 26.6},
+		{52, 27.8}, {50, 30}, {48, 30}, {50, 26.5}, {51.5, 24}, {56, 26.3}, {56.4, 24}, {58.7, 23.6},
+		{59.8, 22.3}, {55.5, 17.5}, {52, 16}, {45, 13}, {43.3, 12.7}, {42.8, 16}, {39, 21.5}, {35, 28},
+		{34.5, 29.5}, {32.6, 30}, {34.5, 31.5}, {35, 33}, {36, 35.8}, {32.5, 36.1}, {28, 36.8}, {26.3, 39.3},
+		{26, 40.8}, {23.5, 40.2}, {22.7, 37}, {21.3, 37.8}, {19.5, 40.7}, {19.5, 42}, {15, 44.8},
+		{13.6, 45.7}, {12.3, 44.5}, {14, 42}, {16, 40}, {18.5, 40.3}, {17, 38.9}, {16, 38}, {15.7, 40},
+		{12.3, 41.7}, {10.5, 42.9}, {9, 44.3}, {6, 43}, {3.2, 42.5}, {3.2, 41.8}, {0.5, 40.6}, {-0.3, 39.5},
+		{0, 38.5}, {-2, 36.7}},
+	// Chukotka, east of the date line
+	{{-180, 69}, {-172, 66.5}, {-175, 65.3}, {-180, 65}},
+	// Africa
+	{{-5.9, 35.8}, {-1, 35.3}, {3, 36.8}, {10, 37.2}, {11, 35}, {10.2, 33.8}, {15.2, 32.3}, {19.5, 30.3},
+		{20, 32.5}, {23, 32.6}, {29, 30.9}, {32.6, 31.2}, {32.6, 30}, {33.5, 27.5}, {35.5, 24}, {37.2, 21},
+		{38.5, 18}, {40, 15.5}, {43.2, 12.5}, {44.5, 10.4}, {51.2, 11.8}, {51, 10.4}, {48, 5}, {42, -1},
+		{39.5, -4.8}, {39, -7}, {40.5, -10.5}, {40.6, -15}, {36.8, -18}, {35.3, -22}, {35.5, -24},
+		{32.9, -26}, {32.4, -28.5}, {30, -31.3}, {27, -33.6}, {22, -34.2}, {20, -34.8}, {18.4, -34},
+		{17.9, -32}, {15.2, -27}, {14.4, -22.5}, {11.8, -17}, {13.6, -12}, {13, -8.5}, {12.2, -6}, {9, -1},
+		{9.8, 3}, {8.5, 4.5}, {5.8, 4.3}, {2.6, 6.3}, {-2, 4.8}, {-7.5, 4.4}, {-12, 7}, {-13.3, 9},
+		{-15, 10.9}, {-17, 12.5}, {-17.2, 14.7}, {-16.3, 19}, {-17, 21}, {-14.5, 26.2}, {-13, 27.7},
+		{-9.7, 30}, {-9.6, 32.5}, {-6.8, 34}},
+	{{49.3, -12}, {50.5, -15.5}, {49.5, -17.5}, {47.2, -24.8}, {45.2, -25.5}, {43.8, -23}, {44.3, -20},
+		{44, -17}, {47, -15}},
+	// Europe's islands
+	{{-5.7, 50}, {1.4, 51.2}, {1.7, 52.7}, {0.2, 53.5}, {-0.2, 54.5}, {-1.6, 55.6}, {-2, 57}, {-1.8, 57.6},
+		{-4, 57.6}, {-3.2, 58.6}, {-5, 58.6}, {-6.2, 56.5}, {-5.5, 55.3}, {-4.8, 54.8}, {-3.2, 54.2},
+		{-3, 53.3}, {-4.5, 52.8}, {-5.2, 51.7}, {-3.5, 51.5}},
+	{{-6, 52.2}, {-6, 53.9}, {-5.5, 55.3}, {-7.5, 55.3}, {-10, 54.2}, {-10.2, 51.8}, {-8, 51.6}},
+	{{-24, 65.5}, {-22, 66.4}, {-16, 66.5}, {-13.5, 65.2}, {-15, 64.3}, {-18.5, 63.4}, {-22.5, 63.8}},
+	{{11, 78.5}, {16, 80.3}, {27, 80.2}, {22, 77.5}, {16, 76.5}},
+	{{52, 71.5}, {55, 73.5}, {60, 76}, {68, 76.8}, {60, 74}, {57, 71}},
+	// Asia's islands
+	{{130, 31}, {131.5, 31.5}, {132, 33.8}, {135, 33.5}, {136.8, 34.3}, {139.8, 35}, {140.9, 36}, {141, 38.2},
+		{142, 39.5}, {141.4, 41.4}, {139.9, 40.6}, {140, 39}, {138.5, 37.8}, {136.8, 37}, {136, 35.7},
+		{133, 35.6}, {131, 34.4}, {129.7, 33.5}},
+	{{140, 41.5}, {141.2, 41.8}, {143.3, 42}, {145.5, 43.3}, {144.3, 44.1}, {141.7, 45.4}, {141.5, 43.3},
+		{140, 42.5}},
+	{{120.1, 23}, {121, 22}, {121.9, 24.6}, {121.5, 25.3}},
+	{{120, 14.5}, {120.6, 18.5}, {122.2, 18.5}, {124, 12.5}, {123, 13}, {121.5, 14}, {120.5, 14}},
+	{{122, 7}, {125.5, 5.8}, {126.5, 7.5}, {125.5, 9.8}, {123.5, 8.5}},
+	{{79.9, 9.8}, {81.9, 7.5}, {81.5, 6.1}, {80.1, 6}},
+	{{109, 1.5}, {111, 2.8}, {113.5, 4.5}, {116, 7}, {119, 5}, {118, 1}, {117.5, -1}, {116.5, -4},
+		{114.5, -4}, {111, -3}, {110, -1.5}},
+	{{95.3, 5.6}, {98, 4}, {100.5, 1.5}, {104.5, -2}, {106, -5.9}, {104.5, -5.9}, {101, -2.5}, {98.5, 1.5}},
+	{{105.5, -6.8}, {108, -6.3}, {112.5, -6.8}, {114.5, -7.8}, {110, -8.2}, {106, -7.4}},
+	{{119, -5.5}, {120.5, -5.5}, {121, -3}, {123.3, -1}, {124.8, 1.5}, {120.5, 1}, {120, -1}, {119, -3}},
+	{{131, -1.3}, {135, -3.3}, {138, -1.5}, {141, -2.6}, {145.8, -5}, {147.5, -6.2}, {150.5, -10.5},
+		{147, -10}, {144, -7.8}, {141, -9.2}, {138, -8.3}, {137.8, -5.2}, {133, -4}, {132, -2.8}},
+	// Oceania
+	{{113.5, -22}, {114, -26}, {115, -34}, {118, -35}, {123.5, -34}, {126, -32.3}, {131, -31.5},
+		{134, -32.5}, {137.7, -35.5}, {138.5, -34.8}, {140, -37.8}, {143, -38.8}, {146.3, -39}, {148, -37.8},
+		{150, -37.2}, {151.5, -33}, {153.5, -28}, {153, -25}, {150.8, -22.5}, {148.7, -20.4}, {146, -18.5},
+		{145.3, -15}, {143.5, -14}, {142.5, -10.7}, {141.5, -13}, {141.6, -17}, {140, -17.7}, {137, -16},
+		{136.5, -12}, {132.5, -11.5}, {130, -13}, {129.5, -15}, {126, -14}, {122, -17.5}, {121, -19.5},
+		{117, -20.7}},
+	{{144.6, -40.7}, {148.3, -40.9}, {148, -43.2}, {146, -43.6}},
+	{{172.7, -34.4}, {174.5, -36.5}, {175.8, -37}, {178.5, -37.7}, {177, -39.5}, {175.2, -41.6},
+		{174.6, -39.9}, {173.8, -39.2}, {174.5, -38}},
+	{{172.7, -40.5}, {174.3, -41.4}, {173.5, -43}, {171, -44.5}, {169, -46.6}, {166.5, -46}, {168, -44},
+		{171.5, -41.8}},
+	// Antarctica
+	{{-180, -90}, {180, -90}, {180, -70}, {150, -68}, {120, -66.5}, {90, -66.5}, {60, -67.5}, {30, -69.5},
+		{0, -70.5}, {-30, -75}, {-60, -73}, {-58, -63.5}, {-65, -67}, {-75, -72}, {-100, -73}, {-130, -74},
+		{-160, -78}, {-180, -77}},
+}
+
+// water are the inland seas inside the Eurasia outline
+var water = [][]point{
+	// Black Sea and Sea of Azov
+	{{28, 41.2}, {28.5, 43.5}, {30, 45.5}, {33.5, 44.5}, {36.5, 45.3}, {38, 47}, {39.5, 47}, {38, 45},
+		{41.5, 42}, {37, 41}, {33, 42}, {29, 41.2}},
+	// Caspian Sea
+	{{47, 43}, {47.5, 45.5}, {50, 46.8}, {53, 46.7}, {53, 42}, {54, 40.5}, {53, 37.5}, {50, 37}, {49, 38.5},
+		{49.5, 40.5}},
+}
+
+var (
+	oceanColor = color.NRGBA{R: 0x1f, G: 0x3b, B: 0x5c, A: 0xff}
+	landColor  = color.NRGBA{R: 0x9c, G: 0xb8, B: 0x8a, A: 0xff}
+	gridColor  = color.NRGBA{R: 0x2c, G: 0x4d, B: 0x73, A: 0xff}
+)
+
+func main() {
+	output := flag.String("o", "world.png", "output file")
+	width := flag.Int("width", 1024, "width in pixels, the height is half of it")
+	flag.Parse()
+
+	w, h := *width, *width/2
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, oceanColor)
+		}
+	}
+
+	// A graticule every 30 degrees, under the land
+	for deg := -150; deg <= 150; deg += 30 {
+		x := int(float64(deg+180) / 360 * float64(w))
+		for y := 0; y < h; y++ {
+			img.SetNRGBA(x, y, gridColor)
+		}
+	}
+	for deg := -60; deg <= 60; deg += 30 {
+		y := int(float64(90-deg) / 180 * float64(h))
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, gridColor)
+		}
+	}
+
+	for _, polygon := range land {
+		fill(img, polygon, landColor)
+	}
+	for _, polygon := range water {
+		fill(img, polygon, oceanColor)
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error creating %s: %v\n", *output, err)
+		os.Exit(1)
+	}
+	defer f.Close()
+	if err := png.Encode(f, img); err != nil {
+		fmt.Fprintf(os.Stderr, "error writing %s: %v\n", *output, err)
+		os.Exit(1)
+	}
+}
+
+// fill paints the inside of a closed polygon, row by row with the even-odd
+// rule
+func fill(img *image.NRGBA, polygon []point, c color.NRGBA) {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	px := func(p point) (float64, float64) {
+		return (p.lon + 180) / 360 * float64(w), (90 - p.lat) / 180 * float64(h)
+	}
+
+	for y := 0; y < h; y++ {
+		center := float64(y) + 0.5
+		var crossings []float64
+		for i := range polygon {
+			x1, y1 := px(polygon[i])
+			x2, y2 := px(polygon[(i+1)%len(polygon)])
+			if (y1 <= center) != (y2 <= center) {
+				crossings = append(crossings, x1+(center-y1)/(y2-y1)*(x2-x1))
+			}
+		}
+		sort.Float64s(crossings)
+		for i := 0; i+1 < len(crossings); i += 2 {
+			for x := int(crossings[i] + 0.5); x < int(crossings[i+1]+0.5) && x < w; x++ {
+				if x >= 0 {
+					img.SetNRGBA(x, y, c)
+				}
+			}
+		}
+	}
+}
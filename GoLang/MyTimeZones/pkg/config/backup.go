@@ -0,0 +1,173 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// BackupDirName is the directory next to the config holding backups
+	BackupDirName = "backups"
+	// backupTimeFormat sorts lexically in time order
+	backupTimeFormat = "20060102-150405.000"
+)
+
+// Backup is a previous version of the config file
+type Backup struct {
+	Path string
+	Time time.Time
+}
+
+// writeFileAtomic replaces path with data so that a crash leaves either the
+// old or the new file, never a partial one. A symlinked path, such as a
+// config kept in a dotfiles repo, stays a link: its target is replaced.
+// An existing file keeps its mode, perm is for new files.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	if target, err := filepath.EvalSymlinks(path); err == nil {
+		path = target
+	}
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	}
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+	// Best effort, a no-op once the rename succeeded
+	defer os.Remove(tmpName)
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpName, perm); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		return err
+	}
+
+	// Persist the rename too, not supported on every platform
+	if d, err := os.Open(dir); err == nil {
+		_ = d.Sync()
+		d.Close()
+	}
+	return nil
+}
+
+// backupDir returns the backup directory for the config at path
+func backupDir(path string) string {
+	return filepath.Join(filepath.Dir(path), BackupDirName)
+}
+
+// backupCurrent copies the file at path into the backup directory unless
+// it already holds next, then prunes all but the newest keep backups
+func backupCurrent(path string, next []byte, keep int) error {
+	if keep <= 0 {
+		return nil
+	}
+	current, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(current, next) {
+		return nil
+	}
+
+	dir := backupDir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)) + "-" + time.Now().Format(backupTimeFormat) + ".json"
+	if err := writeFileAtomic(filepath.Join(dir, name), current, 0644); err != nil {
+		return err
+	}
+
+	backups, err := ListBackups(path)
+	if err != nil {
+		return err
+	}
+	for _, b := range backups[min(keep, len(backups)):] {
+		if err := os.Remove(b.Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListBackups returns the backups of the config at path, newest first
+func ListBackups(path string) ([]Backup, error) {
+	prefix := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)) + "-"
+	entries, err := os.ReadDir(backupDir(path))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []Backup
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		stamp := strings.TrimSuffix(strings.TrimPrefix(name, prefix), ".json")
+		t, err := time.ParseInLocation(backupTimeFormat, stamp, time.Local)
+		if err != nil {
+			continue
+		}
+		backups = append(backups, Backup{Path: filepath.Join(backupDir(path), name), Time: t})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Time.After(backups[j].Time)
+	})
+	return backups, nil
+}
+
+// RestoreBackup replaces the config at path with a backup, after checking
+// the backup is valid. The current file is backed up first.
+func RestoreBackup(path string, backup Backup, keep int) (*AppConfig, error) {
+	data, err := os.ReadFile(backup.Path)
+	if err != nil {
+		return nil, err
+	}
+	migrated, _, err := Migrate(data)
+	if err != nil {
+		return nil, fmt.Errorf("backup %s is not a valid config: %w", filepath.Base(backup.Path), err)
+	}
+	cfg, err := parseConfig(migrated)
+	if err != nil {
+		return nil, fmt.Errorf("backup %s is not a valid config: %w", filepath.Base(backup.Path), err)
+	}
+	// Relative holiday and calendar paths are checked next to the config
+	cfg.path = path
+	if err := cfg.Validate().Err(); err != nil {
+		return nil, fmt.Errorf("backup %s is not a valid config: %w", filepath.Base(backup.Path), err)
+	}
+
+	if err := backupCurrent(path, migrated, keep); err != nil {
+		return nil, fmt.Errorf("failed to back up current config: %w", err)
+	}
+	if err := writeFileAtomic(path, migrated, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write config file: %w", err)
+	}
+	return LoadConfig(path)
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSave_RollingBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	cfg, err := LoadOrCreateConfig(path)
+	if err != nil {
+		t.Fatalf("LoadOrCreateConfig() error = %v", err)
+	}
+	cfg.BackupCount = 2
+
+	for _, width := range []int{801, 802, 803} {
+		cfg.WindowWidth = width
+		if err := cfg.Save(path); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	backups, err := ListBackups(path)
+	if err != nil {
+		t.Fatalf("ListBackups() error = %v", err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("got %d backups, want 2", len(backups))
+	}
+
+	restored, err := RestoreBackup(path, backups[0], cfg.BackupCount)
+	if err != nil {
+		t.Fatalf("RestoreBackup() error = %v", err)
+	}
+	if restored.WindowWidth != 802 {
+		t.Errorf("restored WindowWidth = %d, want 802", restored.WindowWidth)
+	}
+}
+
+func TestRestoreBackup_RelativeHolidayDir(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	if _, err := LoadOrCreateConfig(path); err != nil {
+		t.Fatalf("LoadOrCreateConfig() error = %v", err)
+	}
+	current, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The holiday directory of the backup, next to the config, is broken
+	if err := os.MkdirAll(filepath.Join(dir, "hol"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "hol", "PT.json"), []byte("not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg := DefaultAppConfig
+	cfg.HolidayDir = "hol"
+	data, _ := json.Marshal(cfg)
+	backup := filepath.Join(dir, "backup.json")
+	if err := os.WriteFile(backup, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := RestoreBackup(path, Backup{Path: backup}, 5); err == nil {
+		t.Error("RestoreBackup() accepted a backup whose holiday files don't load")
+	}
+	if data, _ := os.ReadFile(path); string(data) != string(current) {
+		t.Errorf("RestoreBackup() replaced the config:\n%s", data)
+	}
+}
+
+func TestWriteFileAtomic_SymlinkAndMode(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(target, []byte("{}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.Symlink(target, path); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+
+	if err := writeFileAtomic(path, []byte(`{"windowWidth": 900}`), 0644); err != nil {
+		t.Fatalf("writeFileAtomic() error = %v", err)
+	}
+
+	if info, err := os.Lstat(path); err != nil || info.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("%s is no longer a symlink", path)
+	}
+	data, err := os.ReadFile(target)
+	if err != nil || string(data) != `{"windowWidth": 900}` {
+		t.Errorf("target = %q, %v, want the new contents", data, err)
+	}
+	if info, err := os.Stat(target); err != nil {
+		t.Error(err)
+	} else if info.Mode().Perm() != 0600 {
+		t.Errorf("target mode = %v, want 0600", info.Mode().Perm())
+	}
+}
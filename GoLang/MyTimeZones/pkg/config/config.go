@@ -4,59 +4,190 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
+	"github.com/yourusername/MyTimeZones/pkg/server"
 	"github.com/yourusername/MyTimeZones/pkg/timezone"
 )
 
 type AppConfig struct {
+	Version            int                     `json:"version"`
 	WindowWidth        int                     `json:"windowWidth"`
 	WindowHeight       int                     `json:"windowHeight"`
 	RefreshRateSeconds int                     `json:"refreshRateSeconds"`
 	ShowSeconds        bool                    `json:"showSeconds"`
+	DSTWarningDays     int                     `json:"dstWarningDays"`
+	BackupCount        int                     `json:"backupCount"`
 	TimeZones          timezone.TimeZoneConfig `json:"timeZones"`
+	Server             ServerConfig            `json:"server"`
+	// TrayMode keeps the application running in the system tray when the
+	// window is closed
+	TrayMode bool `json:"trayMode"`
+	// HolidayDir holds the .ics and .json holiday files, by default the
+	// holidays directory next to the config file
+	HolidayDir string `json:"holidayDir,omitempty"`
+	// Calendar is the .ics file whose events are shown in every zone
+	Calendar CalendarConfig `json:"calendar"`
+
+	// path is the file the config was loaded from
+	path string
+}
+
+// ServerConfig controls the embedded HTTP/JSON API
+type ServerConfig struct {
+	Enabled bool   `json:"enabled"`
+	Address string `json:"address"`
+}
+
+// CalendarConfig points at a local .ics calendar
+type CalendarConfig struct {
+	// Path is the .ics file, relative to the config file unless absolute
+	Path string `json:"path,omitempty"`
+	// Days is how many days of upcoming events are shown
+	Days int `json:"days"`
 }
 
 var DefaultAppConfig = AppConfig{
+	Version:            CurrentVersion,
 	WindowWidth:        800,
 	WindowHeight:       600,
 	RefreshRateSeconds: 1,
 	ShowSeconds:        true,
+	DSTWarningDays:     14,
+	BackupCount:        5,
 	TimeZones:          timezone.DefaultTimeZoneConfig,
+	Server: ServerConfig{
+		Enabled: false,
+		Address: server.DefaultAddress,
+	},
+	Calendar: CalendarConfig{
+		Days: 7,
+	},
 }
 
 func LoadOrCreateConfig(path string) (*AppConfig, error) {
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		// File does not exist, create it with default config
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, err
+		}
 		data, _ := json.MarshalIndent(DefaultAppConfig, "", "  ")
-		if err := os.WriteFile(path, data, 0644); err != nil {
+		if err := writeFileAtomic(path, data, 0644); err != nil {
 			return nil, err
 		}
 	}
 
+	if err := UpgradeConfig(path); err != nil {
+		return nil, err
+	}
+	return LoadConfig(path)
+}
+
+// LoadConfig reads an existing config file and fills in defaults for
+// missing settings. Older layouts are migrated in memory only, the file is
+// left as it is.
+func LoadConfig(path string) (*AppConfig, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
+	migrated, _, err := Migrate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := parseConfig(migrated)
+	if err != nil {
+		return nil, err
+	}
+	cfg.path = path
+
+	// Alarm firings are recorded in the state file, not in the config. An
+	// unreadable state only means alarms may be reported missed again.
+	if state, err := LoadState(cfg.StatePath()); err == nil {
+		state.ApplyAlarms(cfg.TimeZones.Alarms)
+	}
+
+	return cfg, nil
+}
+
+// parseConfig decodes current version config JSON and fills in defaults
+// for missing settings
+func parseConfig(data []byte) (*AppConfig, error) {
 	var cfg AppConfig
 	if err := json.Unmarshal(data, &cfg); err != nil {
 		return nil, err
 	}
 
+	if cfg.WindowWidth == 0 {
+		cfg.WindowWidth = DefaultAppConfig.WindowWidth
+	}
+	if cfg.WindowHeight == 0 {
+		cfg.WindowHeight = DefaultAppConfig.WindowHeight
+	}
 	if cfg.RefreshRateSeconds < 1 {
 		cfg.RefreshRateSeconds = 1
 	}
+	// Older files have no warning window, a negative value disables it
+	if cfg.DSTWarningDays == 0 {
+		cfg.DSTWarningDays = DefaultAppConfig.DSTWarningDays
+	}
+	// Older files have no backup count, a negative value disables backups
+	if cfg.BackupCount == 0 {
+		cfg.BackupCount = DefaultAppConfig.BackupCount
+	}
+	if cfg.Server.Address == "" {
+		cfg.Server.Address = DefaultAppConfig.Server.Address
+	}
+	if cfg.Calendar.Days == 0 {
+		cfg.Calendar.Days = DefaultAppConfig.Calendar.Days
+	}
 
 	return &cfg, nil
 }
 
+// RefreshRate is how often the clocks are redrawn: every RefreshRateSeconds,
+// but no more than once a minute when seconds are hidden
+func (c *AppConfig) RefreshRate() time.Duration {
+	seconds := max(c.RefreshRateSeconds, 1)
+	if !c.ShowSeconds && seconds < 60 {
+		seconds = 60
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// CalendarPath returns the .ics file to show, or "" when none is set
+func (c *AppConfig) CalendarPath() string {
+	return c.relativePath(c.Calendar.Path)
+}
+
+// relativePath resolves a path relative to the directory of the config file
+func (c *AppConfig) relativePath(path string) string {
+	if path == "" || filepath.IsAbs(path) || c.path == "" {
+		return path
+	}
+	return filepath.Join(filepath.Dir(c.path), path)
+}
+
+// Path returns the file the config was loaded from
+func (c *AppConfig) Path() string {
+	return c.path
+}
+
+// Save writes the config atomically, keeping the previous file among the
+// rolling backups
 func (c *AppConfig) Save(path string) error {
 	data, err := json.MarshalIndent(c, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal config: %w", err)
 	}
 
-	if err := os.WriteFile(path, data, 0644); err != nil {
+	if err := backupCurrent(path, data, c.BackupCount); err != nil {
+		return fmt.Errorf("failed to back up config file: %w", err)
+	}
+	if err := writeFileAtomic(path, data, 0644); err != nil {
 		return fmt.Errorf("failed to write config file: %w", err)
 	}
 
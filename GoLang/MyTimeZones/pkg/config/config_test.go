@@ -0,0 +1,25 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAppConfig_RefreshRate(t *testing.T) {
+	tests := []struct {
+		seconds     int
+		showSeconds bool
+		want        time.Duration
+	}{
+		{1, true, time.Second},
+		{0, true, time.Second},
+		{1, false, time.Minute},
+		{90, false, 90 * time.Second},
+	}
+	for _, tt := range tests {
+		cfg := AppConfig{RefreshRateSeconds: tt.seconds, ShowSeconds: tt.showSeconds}
+		if got := cfg.RefreshRate(); got != tt.want {
+			t.Errorf("RefreshRate(%d, showSeconds %v) = %v, want %v", tt.seconds, tt.showSeconds, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/yourusername/MyTimeZones/pkg/holiday"
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// HolidayDirName is the default holiday directory, next to the config file
+const HolidayDirName = "holidays"
+
+// HolidayPath returns the directory holiday files are loaded from.
+// A relative holidayDir is relative to the config file.
+func (c *AppConfig) HolidayPath() string {
+	dir := c.HolidayDir
+	if dir == "" {
+		dir = HolidayDirName
+	}
+	return c.relativePath(dir)
+}
+
+// LoadHolidays loads every holiday file of the holiday directory
+func (c *AppConfig) LoadHolidays() (*holiday.Calendar, error) {
+	calendar, err := holiday.Load(c.HolidayPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load holidays: %w", err)
+	}
+	return calendar, nil
+}
+
+// validateHolidays warns about holiday sets without a holiday file and
+// holiday events that were skipped
+func (c *AppConfig) validateHolidays(issues *timezone.Issues) {
+	calendar, err := c.LoadHolidays()
+	if err != nil {
+		issues.Add(timezone.SeverityError, "holidayDir", "%v", err)
+		return
+	}
+	for _, warning := range calendar.Warnings() {
+		issues.Add(timezone.SeverityWarning, "holidayDir", "%s", warning)
+	}
+
+	check := func(field, set string) {
+		if set != "" && !calendar.HasSet(set) {
+			issues.Add(timezone.SeverityWarning, field, "no holiday file for %q in %s", set, c.HolidayPath())
+		}
+	}
+	check("timeZones.local.holidays", c.TimeZones.Local.Holidays)
+	for n, entry := range c.TimeZones.Others {
+		check(fmt.Sprintf("timeZones.others[%d].holidays", n), entry.Holidays)
+	}
+	for n, p := range c.TimeZones.People {
+		check(fmt.Sprintf("timeZones.people[%d].holidays", n), p.Holidays)
+	}
+}
@@ -0,0 +1,145 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// CurrentVersion is the config schema version written by this build.
+//
+//	0: the original layout with "local" and "others" at the top level, also
+//	   used by the Python app's *-timezones.json files
+//	1: the V1.2.3 release layout with window settings and a "timeZones" section
+//	2: adds the "version" key
+const CurrentVersion = 2
+
+// migrations[n] upgrades a raw config from version n to n+1
+var migrations = []func(raw map[string]interface{}) error{
+	migrateV0,
+	migrateV1,
+}
+
+// detectVersion returns the schema version of a raw config
+func detectVersion(raw map[string]interface{}) (int, error) {
+	if v, ok := raw["version"]; ok {
+		n, ok := v.(float64)
+		if !ok || n < 0 || n != float64(int(n)) {
+			return 0, fmt.Errorf("invalid config version %v", v)
+		}
+		return int(n), nil
+	}
+	if _, ok := raw["timeZones"]; ok {
+		return 1, nil
+	}
+	if _, ok := raw["local"]; ok {
+		return 0, nil
+	}
+	// Nothing recognisable, treat it as a current file with defaults
+	return CurrentVersion, nil
+}
+
+// Migrate upgrades raw config JSON to CurrentVersion. It returns the
+// upgraded JSON and the version the data was in.
+func Migrate(data []byte) ([]byte, int, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, 0, err
+	}
+
+	from, err := detectVersion(raw)
+	if err != nil {
+		return nil, 0, err
+	}
+	if from > CurrentVersion {
+		return nil, from, fmt.Errorf("config version %d is newer than this build supports (%d)", from, CurrentVersion)
+	}
+	if from == CurrentVersion {
+		return data, from, nil
+	}
+
+	for v := from; v < CurrentVersion; v++ {
+		if err := migrations[v](raw); err != nil {
+			return nil, from, fmt.Errorf("failed to migrate config from version %d: %w", v, err)
+		}
+	}
+	raw["version"] = CurrentVersion
+
+	migrated, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return nil, from, err
+	}
+	return migrated, from, nil
+}
+
+// migrateV0 moves the top level "local" and "others" into "timeZones" and
+// adds the default window settings
+func migrateV0(raw map[string]interface{}) error {
+	timeZones := map[string]interface{}{
+		"local":  raw["local"],
+		"others": raw["others"],
+	}
+	if timeZones["others"] == nil {
+		timeZones["others"] = []interface{}{}
+	}
+	delete(raw, "local")
+	delete(raw, "others")
+
+	defaults, err := json.Marshal(DefaultAppConfig)
+	if err != nil {
+		return err
+	}
+	var defaultRaw map[string]interface{}
+	if err := json.Unmarshal(defaults, &defaultRaw); err != nil {
+		return err
+	}
+	for key, value := range defaultRaw {
+		if _, ok := raw[key]; !ok {
+			raw[key] = value
+		}
+	}
+	raw["timeZones"] = timeZones
+	return nil
+}
+
+// migrateV1 only introduces the version key, which Migrate sets
+func migrateV1(raw map[string]interface{}) error {
+	return nil
+}
+
+// UpgradeConfig migrates an older config file in place, keeping the
+// original as config.json.v1.bak. A current file is left untouched.
+func UpgradeConfig(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	migrated, from, err := Migrate(data)
+	if err != nil {
+		return err
+	}
+	if from == CurrentVersion {
+		return nil
+	}
+	if _, err := backupOriginal(path, data, from); err != nil {
+		return err
+	}
+	if err := writeFileAtomic(path, migrated, 0644); err != nil {
+		return fmt.Errorf("failed to write migrated config: %w", err)
+	}
+	return nil
+}
+
+// backupOriginal copies the pre-migration file next to it, as
+// config.json.v1.bak, adding a timestamp if that name is taken
+func backupOriginal(path string, data []byte, version int) (string, error) {
+	backup := fmt.Sprintf("%s.v%d.bak", path, version)
+	if _, err := os.Stat(backup); err == nil {
+		backup = fmt.Sprintf("%s.v%d.%s.bak", path, version, time.Now().Format("20060102-150405"))
+	}
+	if err := os.WriteFile(backup, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to back up config before migration: %w", err)
+	}
+	return backup, nil
+}
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConfig_Migrates(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		fromVersion int
+		wantLocal   string
+		wantOthers  int
+	}{
+		{
+			name:        "Python app layout",
+			data:        `{"local": {"zone": "Europe/Lisbon", "description": "MyZone"}, "others": [{"zone": "Asia/Tel_Aviv", "description": "CU Office"}]}`,
+			fromVersion: 0,
+			wantLocal:   "Europe/Lisbon",
+			wantOthers:  1,
+		},
+		{
+			name:        "V1.2.3 release layout",
+			data:        `{"windowWidth": 800, "windowHeight": 600, "refreshRateSeconds": 1, "showSeconds": true, "timeZones": {"local": {"zone": "Asia/Tokyo", "description": "Local Time"}, "others": []}}`,
+			fromVersion: 1,
+			wantLocal:   "Asia/Tokyo",
+			wantOthers:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.json")
+			if err := os.WriteFile(path, []byte(tt.data), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := LoadConfig(path)
+			if err != nil {
+				t.Fatalf("LoadConfig() error = %v", err)
+			}
+			if cfg.Version != CurrentVersion {
+				t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+			}
+			if cfg.TimeZones.Local.Zone != tt.wantLocal || len(cfg.TimeZones.Others) != tt.wantOthers {
+				t.Errorf("TimeZones = %+v, want local %s with %d others", cfg.TimeZones, tt.wantLocal, tt.wantOthers)
+			}
+			if cfg.WindowWidth == 0 {
+				t.Error("WindowWidth not filled in by the migration")
+			}
+
+			// Loading only migrates in memory
+			if data, _ := os.ReadFile(path); string(data) != tt.data {
+				t.Errorf("LoadConfig() rewrote the file:\n%s", data)
+			}
+			if _, err := os.Stat(fmt.Sprintf("%s.v%d.bak", path, tt.fromVersion)); !os.IsNotExist(err) {
+				t.Errorf("LoadConfig() wrote a backup, stat error = %v", err)
+			}
+
+			if _, err := LoadOrCreateConfig(path); err != nil {
+				t.Fatalf("LoadOrCreateConfig() error = %v", err)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if version, err := detectVersion(decodeRaw(t, data)); err != nil || version != CurrentVersion {
+				t.Errorf("upgraded file version = %d, %v, want %d", version, err, CurrentVersion)
+			}
+
+			backup, err := os.ReadFile(fmt.Sprintf("%s.v%d.bak", path, tt.fromVersion))
+			if err != nil {
+				t.Fatalf("no backup of the original: %v", err)
+			}
+			if string(backup) != tt.data {
+				t.Errorf("backup = %s, want the original file", backup)
+			}
+		})
+	}
+}
+
+func decodeRaw(t *testing.T, data []byte) map[string]interface{} {
+	t.Helper()
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	return raw
+}
+
+func TestMigrate_NewerVersion(t *testing.T) {
+	if _, _, err := Migrate([]byte(`{"version": 99}`)); err == nil {
+		t.Error("Migrate() of a future version succeeded, want an error")
+	}
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// FileName is the name of the config file in every location
+	FileName = "config.json"
+	// AppDirName is the directory created under the user config directory
+	AppDirName = "MyTimeZones"
+	// EnvPath overrides the config location when set
+	EnvPath = "MYTIMEZONES_CONFIG"
+	// PortableMarker next to the binary turns on portable mode
+	PortableMarker = "portable"
+)
+
+// ResolvePath returns the config file to use. In order of preference:
+//   - flagPath, from --config
+//   - the MYTIMEZONES_CONFIG environment variable
+//   - config.json next to the binary in portable mode, which is on when
+//     portable is set, a "portable" marker file sits next to the binary, or
+//     a config.json already exists there
+//   - config.json in the user config directory, $XDG_CONFIG_HOME/MyTimeZones
+//     on Linux
+func ResolvePath(flagPath string, portable bool) (string, error) {
+	if flagPath != "" {
+		return filepath.Abs(flagPath)
+	}
+	if envPath := os.Getenv(EnvPath); envPath != "" {
+		return filepath.Abs(envPath)
+	}
+
+	if exeDir, err := executableDir(); err == nil {
+		portablePath := filepath.Join(exeDir, FileName)
+		if portable || fileExists(filepath.Join(exeDir, PortableMarker)) || fileExists(portablePath) {
+			return portablePath, nil
+		}
+	} else if portable {
+		return "", fmt.Errorf("portable mode: %w", err)
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the user config directory: %w", err)
+	}
+	return filepath.Join(configDir, AppDirName, FileName), nil
+}
+
+// executableDir returns the directory of the running binary, tests point it
+// elsewhere
+var executableDir = func() (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	exe, err = filepath.EvalSymlinks(exe)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Dir(exe), nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolvePath(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(dir, "xdg"))
+
+	t.Setenv(EnvPath, "")
+	got, err := ResolvePath("", false)
+	if err != nil {
+		t.Fatalf("ResolvePath() error = %v", err)
+	}
+	if want := filepath.Join(dir, "xdg", AppDirName, FileName); got != want {
+		t.Errorf("ResolvePath() = %s, want %s", got, want)
+	}
+
+	t.Setenv(EnvPath, filepath.Join(dir, "env.json"))
+	if got, _ := ResolvePath("", false); got != filepath.Join(dir, "env.json") {
+		t.Errorf("ResolvePath() = %s, want the %s path", got, EnvPath)
+	}
+
+	if got, _ := ResolvePath(filepath.Join(dir, "flag.json"), false); got != filepath.Join(dir, "flag.json") {
+		t.Errorf("ResolvePath() = %s, want the --config path", got)
+	}
+}
+
+func TestResolvePath_Portable(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(dir, "xdg"))
+	t.Setenv(EnvPath, "")
+	userPath := filepath.Join(dir, "xdg", AppDirName, FileName)
+
+	tests := []struct {
+		name     string
+		portable bool
+		files    []string // created next to the binary
+		want     string
+	}{
+		{name: "Not portable", want: userPath},
+		{name: "Portable flag", portable: true, want: FileName},
+		{name: "Marker file", files: []string{PortableMarker}, want: FileName},
+		{name: "Existing config", files: []string{FileName}, want: FileName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exeDir := t.TempDir()
+			saved := executableDir
+			executableDir = func() (string, error) { return exeDir, nil }
+			t.Cleanup(func() { executableDir = saved })
+			for _, name := range tt.files {
+				if err := os.WriteFile(filepath.Join(exeDir, name), nil, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			want := tt.want
+			if want == FileName {
+				want = filepath.Join(exeDir, FileName)
+			}
+			got, err := ResolvePath("", tt.portable)
+			if err != nil {
+				t.Fatalf("ResolvePath() error = %v", err)
+			}
+			if got != want {
+				t.Errorf("ResolvePath() = %s, want %s", got, want)
+			}
+		})
+	}
+}
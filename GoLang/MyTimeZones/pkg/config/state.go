@@ -0,0 +1,83 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// StateFileName is the file next to the config where the application
+// records what happens while it runs. It is written without backups and not
+// watched, so an alarm firing neither pushes the config backups out nor
+// reloads the config.
+const StateFileName = "state.json"
+
+// State is what the application records while it runs
+type State struct {
+	// AlarmsFired is when each alarm last fired or was reported missed, by
+	// alarm name and zone
+	AlarmsFired map[string]time.Time `json:"alarmsFired,omitempty"`
+}
+
+// StatePath returns the state file next to the config
+func (c *AppConfig) StatePath() string {
+	if c.path == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(c.path), StateFileName)
+}
+
+// LoadState reads a state file, an empty state when there is none
+func LoadState(path string) (*State, error) {
+	state := &State{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return state, nil
+}
+
+// Save writes the state atomically
+func (s *State) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal state: %w", err)
+	}
+	return writeFileAtomic(path, data, 0644)
+}
+
+func alarmKey(a timezone.Alarm) string {
+	return a.Name + "|" + a.Zone
+}
+
+// RecordAlarm records that an alarm fired at at
+func (s *State) RecordAlarm(a timezone.Alarm, at time.Time) {
+	if s.AlarmsFired == nil {
+		s.AlarmsFired = make(map[string]time.Time)
+	}
+	if at.After(s.AlarmsFired[alarmKey(a)]) {
+		s.AlarmsFired[alarmKey(a)] = at
+	}
+}
+
+// ApplyAlarms moves LastFired of the alarms to their recorded firing, when
+// that is later
+func (s *State) ApplyAlarms(alarms []timezone.Alarm) {
+	for n, a := range alarms {
+		if fired, ok := s.AlarmsFired[alarmKey(a)]; ok && fired.After(a.LastFired) {
+			alarms[n].LastFired = fired
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+func TestState_AlarmsFired(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	cfg, err := LoadOrCreateConfig(path)
+	if err != nil {
+		t.Fatalf("LoadOrCreateConfig() error = %v", err)
+	}
+	alarm := timezone.Alarm{Name: "Standup", Zone: "Europe/Lisbon", Time: "09:30"}
+	cfg.TimeZones.Alarms = []timezone.Alarm{alarm}
+	if err := cfg.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	before, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	backups, _ := ListBackups(path)
+
+	fired := time.Date(2025, 3, 4, 9, 30, 0, 0, time.UTC)
+	state, err := LoadState(cfg.StatePath())
+	if err != nil {
+		t.Fatalf("LoadState() error = %v", err)
+	}
+	state.RecordAlarm(alarm, fired)
+	if err := state.Save(cfg.StatePath()); err != nil {
+		t.Fatalf("State.Save() error = %v", err)
+	}
+
+	// The config file and its backups are left alone
+	after, _ := os.ReadFile(path)
+	if string(after) != string(before) {
+		t.Error("recording a firing rewrote the config file")
+	}
+	if got, _ := ListBackups(path); len(got) != len(backups) {
+		t.Errorf("got %d backups, want %d", len(got), len(backups))
+	}
+
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if got := loaded.TimeZones.Alarms[0].LastFired; !got.Equal(fired) {
+		t.Errorf("LastFired = %v, want %v", got, fired)
+	}
+}
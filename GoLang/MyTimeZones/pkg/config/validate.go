@@ -0,0 +1,64 @@
+package config
+
+import (
+	"net"
+	"os"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// Limits outside of which settings are reported as suspicious
+const (
+	minWindowSize     = 200
+	maxWindowSize     = 10000
+	maxRefreshRate    = 3600
+	maxDSTWarningDays = 366
+	maxBackupCount    = 100
+	maxCalendarDays   = 366
+)
+
+// Validate checks the whole config and returns every problem found, see
+// timezone.TimeZoneConfig.Validate for the zone checks
+func (c *AppConfig) Validate() timezone.Issues {
+	issues := c.TimeZones.Validate()
+
+	checkSize := func(field string, size int) {
+		switch {
+		case size <= 0:
+			issues.Add(timezone.SeverityError, field, "must be positive, got %d", size)
+		case size < minWindowSize || size > maxWindowSize:
+			issues.Add(timezone.SeverityWarning, field, "%d is outside the sensible range %d-%d", size, minWindowSize, maxWindowSize)
+		}
+	}
+	checkSize("windowWidth", c.WindowWidth)
+	checkSize("windowHeight", c.WindowHeight)
+
+	if c.RefreshRateSeconds > maxRefreshRate {
+		issues.Add(timezone.SeverityWarning, "refreshRateSeconds", "refreshing every %d seconds leaves the clock stale for over an hour", c.RefreshRateSeconds)
+	}
+	if c.DSTWarningDays > maxDSTWarningDays {
+		issues.Add(timezone.SeverityWarning, "dstWarningDays", "%d days ahead always includes the next change", c.DSTWarningDays)
+	}
+	if c.BackupCount > maxBackupCount {
+		issues.Add(timezone.SeverityWarning, "backupCount", "keeping %d backups is more than needed", c.BackupCount)
+	}
+
+	if host, _, err := net.SplitHostPort(c.Server.Address); err != nil {
+		issues.Add(timezone.SeverityError, "server.address", "invalid address %q: %v", c.Server.Address, err)
+	} else if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		issues.Add(timezone.SeverityWarning, "server.address", "%q is reachable from other machines", c.Server.Address)
+	}
+
+	c.validateHolidays(&issues)
+
+	if c.Calendar.Days < 0 || c.Calendar.Days > maxCalendarDays {
+		issues.Add(timezone.SeverityWarning, "calendar.days", "%d is outside the sensible range 1-%d", c.Calendar.Days, maxCalendarDays)
+	}
+	if path := c.CalendarPath(); path != "" {
+		if _, err := os.Stat(path); err != nil {
+			issues.Add(timezone.SeverityWarning, "calendar.path", "%v", err)
+		}
+	}
+
+	return issues
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDelay collapses the burst of events editors produce on save
+const reloadDelay = 250 * time.Millisecond
+
+// Watcher reloads the config file whenever it changes on disk
+type Watcher struct {
+	path string
+	// names are path and, when it is a symlink, the file it points at
+	names    []string
+	watcher  *fsnotify.Watcher
+	onChange func(*AppConfig, error)
+	done     chan struct{}
+}
+
+// WatchConfig calls onChange with the reloaded config every time the file
+// at path changes, or with an error if the new file is unreadable or
+// invalid. onChange runs on the watcher goroutine.
+func WatchConfig(path string, onChange func(*AppConfig, error)) (*Watcher, error) {
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create config watcher: %w", err)
+	}
+
+	// Watch the directory, editors often replace the file rather than write
+	// it. A config symlinked into a dotfiles repo changes in the directory
+	// of its target, so watch that too.
+	names := []string{filepath.Clean(path)}
+	if target, err := filepath.EvalSymlinks(path); err == nil && target != names[0] {
+		names = append(names, target)
+	}
+	for _, name := range names {
+		if err := fsWatcher.Add(filepath.Dir(name)); err != nil {
+			fsWatcher.Close()
+			return nil, fmt.Errorf("failed to watch %s: %w", name, err)
+		}
+	}
+
+	w := &Watcher{
+		path:     names[0],
+		names:    names,
+		watcher:  fsWatcher,
+		onChange: onChange,
+		done:     make(chan struct{}),
+	}
+	go w.run()
+	return w, nil
+}
+
+func (w *Watcher) run() {
+	timer := time.NewTimer(reloadDelay)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			return
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if !slices.Contains(w.names, filepath.Clean(event.Name)) || event.Op == fsnotify.Chmod {
+				continue
+			}
+			timer.Reset(reloadDelay)
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			w.onChange(nil, fmt.Errorf("config watcher: %w", err))
+		case <-timer.C:
+			w.onChange(w.reload())
+		}
+	}
+}
+
+func (w *Watcher) reload() (*AppConfig, error) {
+	cfg, err := LoadConfig(w.path)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate().Err(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Close stops watching the file
+func (w *Watcher) Close() error {
+	close(w.done)
+	return w.watcher.Close()
+}
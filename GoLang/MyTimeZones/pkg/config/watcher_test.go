@@ -0,0 +1,90 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatchConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if _, err := LoadOrCreateConfig(path); err != nil {
+		t.Fatalf("LoadOrCreateConfig() error = %v", err)
+	}
+
+	type result struct {
+		cfg *AppConfig
+		err error
+	}
+	results := make(chan result, 10)
+	watcher, err := WatchConfig(path, func(cfg *AppConfig, err error) {
+		results <- result{cfg, err}
+	})
+	if err != nil {
+		t.Fatalf("WatchConfig() error = %v", err)
+	}
+	defer watcher.Close()
+
+	next := func() result {
+		select {
+		case r := <-results:
+			return r
+		case <-time.After(5 * time.Second):
+			t.Fatal("no reload after writing the config")
+			return result{}
+		}
+	}
+
+	valid := `{"refreshRateSeconds": 5, "timeZones": {"local": {"zone": "Asia/Tokyo", "description": "Tokyo"}}}`
+	if err := os.WriteFile(path, []byte(valid), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if r := next(); r.err != nil || r.cfg.TimeZones.Local.Zone != "Asia/Tokyo" {
+		t.Errorf("reload = %+v, %v, want Asia/Tokyo local zone", r.cfg, r.err)
+	}
+
+	invalid := `{"timeZones": {"local": {"zone": "Mars/Olympus"}}}`
+	if err := os.WriteFile(path, []byte(invalid), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if r := next(); r.err == nil {
+		t.Error("reload of an unknown zone succeeded, want an error")
+	}
+}
+
+func TestWatchConfig_Symlink(t *testing.T) {
+	// config.json links into a dotfiles checkout, edits happen to the target
+	target := filepath.Join(t.TempDir(), "config.json")
+	if _, err := LoadOrCreateConfig(target); err != nil {
+		t.Fatalf("LoadOrCreateConfig() error = %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.Symlink(target, path); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+
+	reloaded := make(chan *AppConfig, 10)
+	watcher, err := WatchConfig(path, func(cfg *AppConfig, err error) {
+		if err == nil {
+			reloaded <- cfg
+		}
+	})
+	if err != nil {
+		t.Fatalf("WatchConfig() error = %v", err)
+	}
+	defer watcher.Close()
+
+	valid := `{"timeZones": {"local": {"zone": "Asia/Tokyo", "description": "Tokyo"}}}`
+	if err := os.WriteFile(target, []byte(valid), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case cfg := <-reloaded:
+		if cfg.TimeZones.Local.Zone != "Asia/Tokyo" {
+			t.Errorf("reloaded local zone = %s, want Asia/Tokyo", cfg.TimeZones.Local.Zone)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no reload after writing the symlink target")
+	}
+}
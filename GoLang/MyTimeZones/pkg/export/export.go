@@ -0,0 +1,170 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// Format is an output format for time info
+type Format string
+
+const (
+	JSON     Format = "json"
+	CSV      Format = "csv"
+	TSV      Format = "tsv"
+	Markdown Format = "markdown"
+)
+
+// Formats lists every supported format
+var Formats = []Format{JSON, CSV, TSV, Markdown}
+
+// ParseFormat returns the format matching name, "md" is accepted for Markdown
+func ParseFormat(name string) (Format, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "md" {
+		return Markdown, nil
+	}
+	for _, f := range Formats {
+		if string(f) == name {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unknown format %q, expected one of json, csv, tsv, markdown", name)
+}
+
+// Record is the stable, machine readable form of a timezone.TimeInfo.
+// Timestamps are ISO-8601 and offsets are whole seconds.
+type Record struct {
+	Zone             string   `json:"zone"`
+	Description      string   `json:"description"`
+	Time             string   `json:"time"`
+	UTC              string   `json:"utc"`
+	UTCOffsetSeconds int      `json:"utcOffsetSeconds"`
+	DiffSeconds      int      `json:"diffSeconds"`
+	Status           string   `json:"status,omitempty"`
+	Holiday          string   `json:"holiday,omitempty"`
+	NextTransition   string   `json:"nextTransition,omitempty"`
+	NextOffset       *int     `json:"nextOffsetSeconds,omitempty"`
+	Group            string   `json:"group,omitempty"`
+	Tags             []string `json:"tags,omitempty"`
+}
+
+// columns are the field names used as CSV, TSV and Markdown headers,
+// matching the JSON keys of Record. Tags are joined with ";".
+var columns = []string{
+	"zone", "description", "time", "utc", "utcOffsetSeconds",
+	"diffSeconds", "status", "holiday", "nextTransition", "nextOffsetSeconds",
+	"group", "tags",
+}
+
+// NewRecords converts time info into records
+func NewRecords(timeInfo []timezone.TimeInfo) []Record {
+	records := make([]Record, 0, len(timeInfo))
+	for _, info := range timeInfo {
+		r := Record{
+			Zone:             info.Name,
+			Description:      info.Description,
+			Time:             info.Instant.Format(time.RFC3339),
+			UTC:              info.Instant.UTC().Format(time.RFC3339),
+			UTCOffsetSeconds: info.UTCOffset,
+			DiffSeconds:      info.DiffSeconds,
+			Status:           info.Status,
+			Holiday:          info.Holiday,
+			Group:            info.Group,
+			Tags:             info.Tags,
+		}
+		if info.Transition != nil {
+			r.NextTransition = info.Transition.At.UTC().Format(time.RFC3339)
+			next := info.Transition.NewOffset
+			r.NextOffset = &next
+		}
+		records = append(records, r)
+	}
+	return records
+}
+
+func (r Record) values() []string {
+	next := ""
+	if r.NextOffset != nil {
+		next = strconv.Itoa(*r.NextOffset)
+	}
+	return []string{
+		r.Zone, r.Description, r.Time, r.UTC, strconv.Itoa(r.UTCOffsetSeconds),
+		strconv.Itoa(r.DiffSeconds), r.Status, r.Holiday, r.NextTransition, next,
+		r.Group, strings.Join(r.Tags, ";"),
+	}
+}
+
+// Write serializes time info to out in the given format
+func Write(out io.Writer, format Format, timeInfo []timezone.TimeInfo) error {
+	records := NewRecords(timeInfo)
+	switch format {
+	case JSON:
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(records)
+	case CSV, TSV:
+		w := csv.NewWriter(out)
+		if format == TSV {
+			w.Comma = '\t'
+		}
+		if err := w.Write(columns); err != nil {
+			return err
+		}
+		for _, r := range records {
+			if err := w.Write(r.values()); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+	case Markdown:
+		return writeMarkdown(out, records)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// String serializes time info to a string, for the clipboard
+func String(format Format, timeInfo []timezone.TimeInfo) (string, error) {
+	var b strings.Builder
+	if err := Write(&b, format, timeInfo); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func writeMarkdown(out io.Writer, records []Record) error {
+	escape := strings.NewReplacer("|", `\|`, "\n", " ")
+	row := func(cells []string) error {
+		for i, c := range cells {
+			cells[i] = escape.Replace(c)
+		}
+		_, err := fmt.Fprintf(out, "| %s |\n", strings.Join(cells, " | "))
+		return err
+	}
+
+	if err := row(append([]string(nil), columns...)); err != nil {
+		return err
+	}
+	separator := make([]string, len(columns))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	if err := row(separator); err != nil {
+		return err
+	}
+	for _, r := range records {
+		if err := row(r.values()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
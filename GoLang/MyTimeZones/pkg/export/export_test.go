@@ -0,0 +1,45 @@
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+func TestWrite(t *testing.T) {
+	manager, err := timezone.NewManagerFromConfig(timezone.TimeZoneConfig{
+		Local:  timezone.TimeZoneEntry{Zone: "Europe/Lisbon", Description: "Lisbon"},
+		Others: []timezone.TimeZoneEntry{{Zone: "Asia/Kolkata", Description: "Kolkata"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+	at, _ := timezone.ParseTimeIn("2025-01-15 12:00", "Europe/Lisbon")
+	timeInfo, err := manager.GetTimeInfoAt(at)
+	if err != nil {
+		t.Fatalf("GetTimeInfoAt() error = %v", err)
+	}
+
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{CSV, "Asia/Kolkata,Kolkata,2025-01-15T17:30:00+05:30,2025-01-15T12:00:00Z,19800,19800,,,,,,\n"},
+		{TSV, "Asia/Kolkata\tKolkata\t2025-01-15T17:30:00+05:30\t2025-01-15T12:00:00Z\t19800\t19800\t\t\t\t\t\t\n"},
+		{JSON, `"utcOffsetSeconds": 19800`},
+		{Markdown, "| Asia/Kolkata | Kolkata | 2025-01-15T17:30:00+05:30 |"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			got, err := String(tt.format, timeInfo)
+			if err != nil {
+				t.Fatalf("String() error = %v", err)
+			}
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("String() = %q, want it to contain %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Package holiday loads offline public holiday calendars. Each file in the
+// holiday directory is one holiday set named after the file, so PT.ics and
+// PT.json both define the set "PT".
+package holiday
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yourusername/MyTimeZones/pkg/ical"
+)
+
+// recurringYears is how far past today recurring holidays are expanded
+const recurringYears = 2
+
+// Holiday is one entry of a JSON holiday file
+type Holiday struct {
+	Date string `json:"date"` // YYYY-MM-DD
+	Name string `json:"name"`
+}
+
+// Calendar holds every loaded holiday set and implements timezone.Holidays
+type Calendar struct {
+	// sets maps an upper cased set name to dates and holiday names
+	sets  map[string]map[string]string
+	names []string
+	// warnings are the events skipped while loading, see Warnings
+	warnings []string
+}
+
+// NewCalendar returns an empty calendar
+func NewCalendar() *Calendar {
+	return &Calendar{sets: make(map[string]map[string]string)}
+}
+
+// Load reads every .ics and .json file of dir. A missing directory is an
+// empty calendar.
+func Load(dir string) (*Calendar, error) {
+	c := NewCalendar()
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".ics" && ext != ".json") {
+			continue
+		}
+		if err := c.LoadFile(filepath.Join(dir, entry.Name())); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// LoadFile adds the holidays of one .ics or .json file to the set named
+// after the file
+func (c *Calendar) LoadFile(path string) error {
+	name := entrySet(path)
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// A file without holidays still defines its set
+	c.set(name)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		var holidays []Holiday
+		if err := json.NewDecoder(file).Decode(&holidays); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		for _, h := range holidays {
+			day, err := time.Parse("2006-01-02", h.Date)
+			if err != nil {
+				return fmt.Errorf("%s: invalid date %q", path, h.Date)
+			}
+			c.Add(name, day, h.Name)
+		}
+	case ".ics":
+		// Events that can't be placed are skipped, the rest of the file loads
+		cal, err := ical.ParseCalendar(file, time.UTC)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		for _, warning := range cal.Warnings {
+			c.warnings = append(c.warnings, fmt.Sprintf("%s: %s", path, warning))
+		}
+		events := cal.Events
+		// Every holiday since the first, recurring ones up to a few years ahead
+		from, to := time.Now(), time.Now().AddDate(recurringYears, 0, 0)
+		for _, e := range events {
+			if e.Start.Before(from) {
+				from = e.Start
+			}
+			if e.End.After(to) {
+				to = e.End
+			}
+		}
+		occurrences, err := ical.Occurrences(events, from, to)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		for _, o := range occurrences {
+			// Every calendar day the event covers, the end is exclusive
+			for day := o.Start; day.Before(o.End) || day.Equal(o.Start); day = day.AddDate(0, 0, 1) {
+				c.Add(name, day, o.Summary)
+			}
+		}
+	default:
+		return fmt.Errorf("%s: unsupported holiday file, expected .ics or .json", path)
+	}
+	return nil
+}
+
+// Add records a holiday on the calendar day of day
+func (c *Calendar) Add(set string, day time.Time, name string) {
+	days := c.set(set)
+	date := day.Format("2006-01-02")
+	if previous, ok := days[date]; ok && previous != name {
+		name = previous + ", " + name
+	}
+	days[date] = name
+}
+
+func (c *Calendar) set(name string) map[string]string {
+	key := strings.ToUpper(name)
+	days, ok := c.sets[key]
+	if !ok {
+		days = make(map[string]string)
+		c.sets[key] = days
+		c.names = append(c.names, name)
+		sort.Strings(c.names)
+	}
+	return days
+}
+
+// Holiday returns the name of the holiday of set on the calendar day of day,
+// in day's location
+func (c *Calendar) Holiday(set string, day time.Time) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	name, ok := c.sets[strings.ToUpper(set)][day.Format("2006-01-02")]
+	return name, ok
+}
+
+// Sets returns the loaded set names, sorted
+func (c *Calendar) Sets() []string {
+	if c == nil {
+		return nil
+	}
+	return c.names
+}
+
+// Warnings returns the problems met loading .ics files, such as events
+// skipped for an RRULE that can't be expanded
+func (c *Calendar) Warnings() []string {
+	if c == nil {
+		return nil
+	}
+	return c.warnings
+}
+
+// HasSet reports whether a set was loaded, ignoring case
+func (c *Calendar) HasSet(set string) bool {
+	if c == nil {
+		return false
+	}
+	_, ok := c.sets[strings.ToUpper(set)]
+	return ok
+}
+
+func entrySet(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
@@ -0,0 +1,84 @@
+package holiday
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoad(t *testing.T) {
+	calendar, err := Load("testdata")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got, want := calendar.Sets(), []string{"JP", "PT"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sets() = %v, want %v", got, want)
+	}
+
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	tests := []struct {
+		set  string
+		day  time.Time
+		want string
+	}{
+		{"PT", time.Date(2025, 12, 25, 23, 0, 0, 0, time.UTC), "Christmas Day"},
+		{"pt", time.Date(2025, 4, 25, 9, 0, 0, 0, time.UTC), "Freedom Day"},
+		{"JP", time.Date(2025, 4, 29, 1, 0, 0, 0, tokyo), "Showa Day"},
+		{"JP", time.Date(2025, 5, 5, 12, 0, 0, 0, tokyo), "Golden Week"},
+		{"JP", time.Date(2025, 5, 6, 12, 0, 0, 0, tokyo), ""},
+		{"JP", time.Date(2024, 1, 1, 12, 0, 0, 0, tokyo), "New Year's Day"},
+		{"JP", time.Date(2026, 1, 1, 12, 0, 0, 0, tokyo), "New Year's Day"},
+		{"JP", time.Date(time.Now().Year()+1, 1, 1, 12, 0, 0, 0, tokyo), "New Year's Day"},
+		{"JP", time.Date(2025, 7, 21, 12, 0, 0, 0, tokyo), ""},
+		{"PT", time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC), ""},
+		{"XX", time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), ""},
+	}
+	for _, tt := range tests {
+		got, ok := calendar.Holiday(tt.set, tt.day)
+		if got != tt.want || ok != (tt.want != "") {
+			t.Errorf("Holiday(%s, %s) = %q, %v, want %q", tt.set, tt.day, got, ok, tt.want)
+		}
+	}
+}
+
+func TestLoad_MissingDir(t *testing.T) {
+	calendar, err := Load("testdata/missing")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(calendar.Sets()) != 0 {
+		t.Errorf("Sets() = %v, want none", calendar.Sets())
+	}
+}
+
+func TestLoad_SkipsUnsupportedRule(t *testing.T) {
+	dir := t.TempDir()
+	data := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20250101\r\nRRULE:FREQ=YEARLY\r\nSUMMARY:New Year\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART:20250101T000000Z\r\nRRULE:FREQ=HOURLY\r\nSUMMARY:Bells\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20251225\r\nSUMMARY:Christmas\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+	if err := os.WriteFile(filepath.Join(dir, "XX.ics"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "PT.json"), []byte(`[{"date": "2025-04-25", "name": "Freedom Day"}]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	calendar, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	for _, h := range []struct{ set, date string }{{"XX", "2026-01-01"}, {"XX", "2025-12-25"}, {"PT", "2025-04-25"}} {
+		day, _ := time.Parse("2006-01-02", h.date)
+		if _, ok := calendar.Holiday(h.set, day); !ok {
+			t.Errorf("Holiday(%s, %s) missing", h.set, h.date)
+		}
+	}
+	if w := calendar.Warnings(); len(w) != 1 || !strings.Contains(w[0], "XX.ics") || !strings.Contains(w[0], `"Bells"`) {
+		t.Errorf("Warnings() = %q, want the hourly Bells skipped", w)
+	}
+}
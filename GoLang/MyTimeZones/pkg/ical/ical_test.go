@@ -0,0 +1,286 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+func TestParse(t *testing.T) {
+	data := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:1\r\n" +
+		"SUMMARY:Planning\\, weekly\r\n" +
+		"DTSTART;TZID=Europe/Lisbon:20250330T160000\r\n" +
+		"DURATION:PT1H30M\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:2\r\n" +
+		"SUMMARY:Day\r\n" +
+		"  off\r\n" +
+		"DTSTART;VALUE=DATE:20250401\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	events, err := Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Parse() returned %d events, want 2", len(events))
+	}
+
+	lisbon, _ := time.LoadLocation("Europe/Lisbon")
+	if e := events[0]; e.Summary != "Planning, weekly" || !e.Start.Equal(time.Date(2025, 3, 30, 16, 0, 0, 0, lisbon)) || e.End.Sub(e.Start) != 90*time.Minute {
+		t.Errorf("events[0] = %+v", e)
+	}
+	if e := events[1]; e.Summary != "Day off" || !e.AllDay || e.End.Sub(e.Start) != 24*time.Hour {
+		t.Errorf("events[1] = %+v", e)
+	}
+}
+
+func TestParseCalendar_Zones(t *testing.T) {
+	// An Outlook style export: a Windows TZID, a VTIMEZONE under a name no
+	// zone database knows, a floating time and a TZID nothing defines
+	data := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VTIMEZONE\r\n" +
+		"TZID:Customized Time Zone\r\n" +
+		"BEGIN:STANDARD\r\n" +
+		"DTSTART:16010101T030000\r\n" +
+		"TZOFFSETFROM:+0200\r\n" +
+		"TZOFFSETTO:+0100\r\n" +
+		"RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=10\r\n" +
+		"END:STANDARD\r\n" +
+		"BEGIN:DAYLIGHT\r\n" +
+		"DTSTART:16010101T020000\r\n" +
+		"TZOFFSETFROM:+0100\r\n" +
+		"TZOFFSETTO:+0200\r\n" +
+		"RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=3\r\n" +
+		"END:DAYLIGHT\r\n" +
+		"END:VTIMEZONE\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"SUMMARY:Windows\r\n" +
+		"DTSTART;TZID=\"W. Europe Standard Time\":20250715T100000\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"SUMMARY:Summer\r\n" +
+		"DTSTART;TZID=Customized Time Zone:20250715T100000\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"SUMMARY:Winter\r\n" +
+		"DTSTART;TZID=Customized Time Zone:20250115T100000\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"SUMMARY:Floating\r\n" +
+		"DTSTART:20250715T100000\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"SUMMARY:Unknown\r\n" +
+		"DTSTART;TZID=Atlantis Standard Time:20250715T100000\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	cal, err := ParseCalendar(strings.NewReader(data), tokyo)
+	if err != nil {
+		t.Fatalf("ParseCalendar() error = %v", err)
+	}
+	want := map[string]time.Time{
+		"Windows":  time.Date(2025, 7, 15, 8, 0, 0, 0, time.UTC),
+		"Summer":   time.Date(2025, 7, 15, 8, 0, 0, 0, time.UTC),
+		"Winter":   time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC),
+		"Floating": time.Date(2025, 7, 15, 1, 0, 0, 0, time.UTC),
+	}
+	if len(cal.Events) != len(want) {
+		t.Fatalf("ParseCalendar() returned %d events, want %d", len(cal.Events), len(want))
+	}
+	for _, e := range cal.Events {
+		if !e.Start.Equal(want[e.Summary]) {
+			t.Errorf("%s starts %v, want %v", e.Summary, e.Start.UTC(), want[e.Summary])
+		}
+	}
+	if len(cal.Warnings) != 1 || !strings.Contains(cal.Warnings[0], "Atlantis Standard Time") {
+		t.Errorf("Warnings = %q, want the unknown TZID", cal.Warnings)
+	}
+}
+
+func TestParseCalendar_MissingStart(t *testing.T) {
+	data := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\nSUMMARY:Undated\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nSUMMARY:Review\r\nDTSTART:20250303T100000Z\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	cal, err := ParseCalendar(strings.NewReader(data), time.UTC)
+	if err != nil {
+		t.Fatalf("ParseCalendar() error = %v", err)
+	}
+	if len(cal.Events) != 1 || cal.Events[0].Summary != "Review" {
+		t.Errorf("Events = %+v, want the Review only", cal.Events)
+	}
+	if len(cal.Warnings) != 1 || !strings.Contains(cal.Warnings[0], `"Undated"`) || !strings.Contains(cal.Warnings[0], "line 2") {
+		t.Errorf("Warnings = %q, want the undated event at line 2", cal.Warnings)
+	}
+}
+
+func TestWindowsZones(t *testing.T) {
+	for name, zone := range windowsZones {
+		if _, err := time.LoadLocation(zone); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestWrite_RoundTrip(t *testing.T) {
+	manager, err := timezone.NewManagerFromConfig(timezone.TimeZoneConfig{
+		Local:  timezone.TimeZoneEntry{Zone: "Europe/Lisbon", Description: "Lisbon"},
+		Others: []timezone.TimeZoneEntry{{Zone: "America/New_York", Description: "New York"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+	start, _ := timezone.ParseTimeIn("2025-03-30 16:00", "America/New_York")
+	event, err := NewMeeting(manager, "Quarterly review; all hands", start, time.Hour)
+	if err != nil {
+		t.Fatalf("NewMeeting() error = %v", err)
+	}
+
+	var b strings.Builder
+	if err := Write(&b, event); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	out := b.String()
+
+	for _, want := range []string{
+		"DTSTART;TZID=America/New_York:20250330T160000\r\n",
+		"BEGIN:VTIMEZONE\r\nTZID:America/New_York\r\n",
+		// DST started on 2025-03-09 at 02:00 EST
+		"BEGIN:DAYLIGHT\r\nDTSTART:20250309T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\nTZNAME:EDT\r\n",
+		"SUMMARY:Quarterly review\\; all hands\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Write() output lacks %q:\n%s", want, out)
+		}
+	}
+	for _, line := range strings.Split(out, "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line longer than 75 octets: %q", line)
+		}
+	}
+
+	events, err := Parse(strings.NewReader(out))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(events) != 1 || !events[0].Start.Equal(start) || events[0].Summary != event.Summary {
+		t.Fatalf("Parse() = %+v, want the written event", events)
+	}
+	if desc := events[0].Description; !strings.Contains(desc, "Lisbon (Europe/Lisbon): Sun 2025-03-30 21:00 - 22:00 WEST") {
+		t.Errorf("Description = %q, want the Lisbon time", desc)
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	data := "BEGIN:VCALENDAR\r\n" +
+		// Weekly on Monday at 09:00 New York time, across the March DST change
+		"BEGIN:VEVENT\r\nUID:standup\r\nSUMMARY:Standup\r\n" +
+		"DTSTART;TZID=America/New_York:20250303T090000\r\nDTEND;TZID=America/New_York:20250303T091500\r\n" +
+		"RRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=4\r\n" +
+		"EXDATE;TZID=America/New_York:20250317T090000\r\n" +
+		"END:VEVENT\r\n" +
+		// The instance of 2025-03-24 moved to 10:00
+		"BEGIN:VEVENT\r\nUID:standup\r\nSUMMARY:Standup (moved)\r\n" +
+		"RECURRENCE-ID;TZID=America/New_York:20250324T090000\r\n" +
+		"DTSTART;TZID=America/New_York:20250324T100000\r\nDTEND;TZID=America/New_York:20250324T101500\r\n" +
+		"END:VEVENT\r\n" +
+		// Last Friday of every month
+		"BEGIN:VEVENT\r\nUID:demo\r\nSUMMARY:Demo\r\n" +
+		"DTSTART:20250131T150000Z\r\nDURATION:PT1H\r\n" +
+		"RRULE:FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20250430T000000Z\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	events, err := Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	occurrences, err := Occurrences(events, from, from.AddDate(0, 2, 0))
+	if err != nil {
+		t.Fatalf("Occurrences() error = %v", err)
+	}
+
+	var got []string
+	for _, o := range occurrences {
+		got = append(got, o.Summary+" "+o.Start.UTC().Format("2006-01-02 15:04")+" "+o.End.Sub(o.Start).String())
+	}
+	want := []string{
+		"Standup 2025-03-03 14:00 15m0s",
+		"Standup 2025-03-10 13:00 15m0s", // 09:00 EDT after the change
+		"Standup (moved) 2025-03-24 14:00 15m0s",
+		"Demo 2025-03-28 15:00 1h0m0s",
+		"Demo 2025-04-25 15:00 1h0m0s",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Occurrences() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestOccurrences_SkipsUnsupportedRule(t *testing.T) {
+	data := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\nUID:review\r\nSUMMARY:Review\r\nDTSTART:20250303T100000Z\r\nDURATION:PT1H\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:poll\r\nSUMMARY:Poll\r\nDTSTART:20250303T100000Z\r\nRRULE:FREQ=HOURLY;COUNT=3\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	cal, err := ParseCalendar(strings.NewReader(data), time.UTC)
+	if err != nil {
+		t.Fatalf("ParseCalendar() error = %v", err)
+	}
+	if len(cal.Warnings) != 1 || !strings.Contains(cal.Warnings[0], `"Poll"`) || !strings.Contains(cal.Warnings[0], "HOURLY") {
+		t.Errorf("Warnings = %q, want the hourly Poll skipped", cal.Warnings)
+	}
+
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	occurrences, err := Occurrences(cal.Events, from, from.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatalf("Occurrences() error = %v", err)
+	}
+	if len(occurrences) != 1 || occurrences[0].Summary != "Review" {
+		t.Errorf("Occurrences() = %+v, want the Review only", occurrences)
+	}
+}
+
+func TestRecurrence_Expand(t *testing.T) {
+	start := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		rule string
+		want []string
+	}{
+		// Months without a 31st are skipped
+		{"FREQ=MONTHLY;COUNT=3", []string{"2024-01-31", "2024-03-31", "2024-05-31"}},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3", []string{"2024-01-31", "2024-02-29", "2024-03-31"}},
+		{"FREQ=DAILY;INTERVAL=2;COUNT=3", []string{"2024-01-31", "2024-02-02", "2024-02-04"}},
+		// DTSTART, a Wednesday, is the first of the COUNT instances
+		{"FREQ=WEEKLY;BYDAY=TU,TH;COUNT=3", []string{"2024-01-31", "2024-02-01", "2024-02-06"}},
+		{"FREQ=YEARLY;BYMONTH=2;BYDAY=1MO;UNTIL=20260101", []string{"2024-01-31", "2024-02-05", "2025-02-03"}},
+		// Without BYMONTH, BYDAY spans the whole year
+		{"FREQ=YEARLY;BYDAY=MO;COUNT=4", []string{"2024-01-31", "2024-02-05", "2024-02-12", "2024-02-19"}},
+		{"FREQ=YEARLY;BYDAY=20MO;COUNT=3", []string{"2024-01-31", "2024-05-13", "2025-05-19"}},
+		{"FREQ=YEARLY;BYDAY=-1FR;COUNT=2", []string{"2024-01-31", "2024-12-27"}},
+		{"FREQ=YEARLY;BYMONTHDAY=15;COUNT=3", []string{"2024-01-31", "2024-02-15", "2024-03-15"}},
+	}
+	for _, tt := range tests {
+		rule, err := ParseRecurrence(tt.rule, time.UTC)
+		if err != nil {
+			t.Fatalf("ParseRecurrence(%s) error = %v", tt.rule, err)
+		}
+		var got []string
+		for _, s := range rule.Expand(start, start.AddDate(5, 0, 0)) {
+			got = append(got, s.Format("2006-01-02"))
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("%s = %v, want %v", tt.rule, got, tt.want)
+		}
+	}
+}
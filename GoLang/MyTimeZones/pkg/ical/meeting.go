@@ -0,0 +1,48 @@
+package ical
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// NewMeeting returns an event starting at start, in start's location, whose
+// description lists the matching local time of every configured zone
+func NewMeeting(m *timezone.Manager, summary string, start time.Time, duration time.Duration) (Event, error) {
+	if duration <= 0 {
+		return Event{}, fmt.Errorf("meeting duration must be positive")
+	}
+	end := start.Add(duration)
+	timeInfo, err := m.GetTimeInfoAt(start)
+	if err != nil {
+		return Event{}, err
+	}
+
+	lines := make([]string, 0, len(timeInfo))
+	for _, info := range timeInfo {
+		zoneEnd := end.In(info.Instant.Location())
+		endLayout := "15:04 MST"
+		if zoneEnd.YearDay() != info.Instant.YearDay() {
+			endLayout = "Mon 15:04 MST"
+		}
+		line := fmt.Sprintf("%s (%s): %s - %s", info.Description, info.Name,
+			info.Instant.Format("Mon 2006-01-02 15:04"), zoneEnd.Format(endLayout))
+		if info.Status != "" {
+			line += ", " + info.Status
+		}
+		lines = append(lines, line)
+	}
+
+	// A stable UID, so exporting the same meeting twice updates one event
+	sum := sha1.Sum([]byte(summary + start.UTC().Format(time.RFC3339) + duration.String()))
+	return Event{
+		UID:         fmt.Sprintf("%x@mytimezones", sum[:8]),
+		Summary:     summary,
+		Description: strings.Join(lines, "\n"),
+		Start:       start,
+		End:         end,
+	}, nil
+}
@@ -0,0 +1,301 @@
+// Package ical reads and writes the parts of iCalendar (RFC 5545) files the
+// application needs: events with their start, end and summary.
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Event is a VEVENT. All day events start and end at midnight in UTC, with
+// an exclusive end date.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	// RRule is the raw recurrence rule, see ParseRecurrence
+	RRule   string
+	ExDates []time.Time
+	// RecurrenceID is set on an event overriding one instance of a rule
+	RecurrenceID time.Time
+	Cancelled    bool
+}
+
+// Property is one unfolded content line, such as
+// DTSTART;TZID=Europe/Lisbon:20250101T090000
+type Property struct {
+	Name   string
+	Params map[string]string
+	Value  string
+	Line   int
+}
+
+// Calendar is the content of an .ics file
+type Calendar struct {
+	Events []Event
+	// Warnings are the problems that don't stop the calendar from loading,
+	// such as events skipped for a TZID that can't be resolved, a missing
+	// DTSTART or an RRULE that can't be expanded
+	Warnings []string
+}
+
+// Parse reads every VEVENT of a calendar, with floating times in UTC. Events
+// whose TZID can't be resolved are skipped, see ParseCalendar.
+func Parse(r io.Reader) ([]Event, error) {
+	cal, err := ParseCalendar(r, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+	return cal.Events, nil
+}
+
+// ParseCalendar reads every VEVENT of a calendar. A TZID is an IANA zone, a
+// Windows zone name as Outlook writes them, or a VTIMEZONE of the file;
+// floating times are in local. Events with a TZID that is none of these are
+// skipped and reported in the warnings rather than placed at a guessed
+// time, as are events without a DTSTART and events whose RRULE
+// ParseRecurrence rejects, so Occurrences can expand every event returned.
+func ParseCalendar(r io.Reader, local *time.Location) (*Calendar, error) {
+	properties, err := ReadProperties(r)
+	if err != nil {
+		return nil, err
+	}
+	timezones, err := readTimezones(properties)
+	if err != nil {
+		return nil, err
+	}
+	zones := &zoneResolver{local: local, timezones: timezones, resolved: make(map[string]*time.Location), now: time.Now()}
+
+	cal := &Calendar{}
+	// skipped counts the events skipped for each unknown TZID, in the order
+	// they appear
+	var unknown []string
+	skipped := make(map[string]int)
+
+	var current *Event
+	var currentLine int
+	var currentUnknown string
+	var duration time.Duration
+	parseTime := func(p Property) (time.Time, bool, error) {
+		t, allDay, err := zones.parseTime(p)
+		var zoneErr *unknownZoneError
+		if errors.As(err, &zoneErr) {
+			currentUnknown = zoneErr.tzid
+			return t, allDay, nil
+		}
+		return t, allDay, err
+	}
+	for _, p := range properties {
+		switch {
+		case p.Name == "BEGIN" && strings.EqualFold(p.Value, "VEVENT"):
+			current, currentLine, currentUnknown, duration = &Event{}, p.Line, "", 0
+		case p.Name == "END" && strings.EqualFold(p.Value, "VEVENT"):
+			if current == nil {
+				continue
+			}
+			if currentUnknown != "" {
+				if skipped[currentUnknown] == 0 {
+					unknown = append(unknown, currentUnknown)
+				}
+				skipped[currentUnknown]++
+				current = nil
+				continue
+			}
+			if current.Start.IsZero() {
+				cal.Warnings = append(cal.Warnings, fmt.Sprintf("line %d: event %q skipped, it has no DTSTART", currentLine, current.Summary))
+				current = nil
+				continue
+			}
+			if current.RRule != "" {
+				if _, err := ParseRecurrence(current.RRule, current.Start.Location()); err != nil {
+					cal.Warnings = append(cal.Warnings, fmt.Sprintf("line %d: event %q skipped, %v", currentLine, current.Summary, err))
+					current = nil
+					continue
+				}
+			}
+			if current.End.IsZero() {
+				switch {
+				case duration > 0:
+					current.End = current.Start.Add(duration)
+				case current.AllDay:
+					current.End = current.Start.AddDate(0, 0, 1)
+				default:
+					current.End = current.Start
+				}
+			}
+			cal.Events = append(cal.Events, *current)
+			current = nil
+		case current == nil:
+			continue
+		case p.Name == "UID":
+			current.UID = Unescape(p.Value)
+		case p.Name == "SUMMARY":
+			current.Summary = Unescape(p.Value)
+		case p.Name == "DESCRIPTION":
+			current.Description = Unescape(p.Value)
+		case p.Name == "DTSTART":
+			t, allDay, err := parseTime(p)
+			if err != nil {
+				return nil, err
+			}
+			current.Start, current.AllDay = t, allDay
+		case p.Name == "DTEND":
+			t, _, err := parseTime(p)
+			if err != nil {
+				return nil, err
+			}
+			current.End = t
+		case p.Name == "RRULE":
+			current.RRule = p.Value
+		case p.Name == "EXDATE":
+			for _, value := range strings.Split(p.Value, ",") {
+				ex := p
+				ex.Value = strings.TrimSpace(value)
+				t, _, err := parseTime(ex)
+				if err != nil {
+					return nil, err
+				}
+				current.ExDates = append(current.ExDates, t)
+			}
+		case p.Name == "RECURRENCE-ID":
+			t, _, err := parseTime(p)
+			if err != nil {
+				return nil, err
+			}
+			current.RecurrenceID = t
+		case p.Name == "STATUS":
+			current.Cancelled = strings.EqualFold(p.Value, "CANCELLED")
+		case p.Name == "DURATION":
+			d, err := ParseDuration(p.Value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", p.Line, err)
+			}
+			duration = d
+		}
+	}
+
+	for _, tzid := range unknown {
+		cal.Warnings = append(cal.Warnings, fmt.Sprintf("%d events skipped, unknown time zone %q", skipped[tzid], tzid))
+	}
+	return cal, nil
+}
+
+// ParseDuration parses an RFC 5545 duration such as PT1H30M or P1D
+func ParseDuration(value string) (time.Duration, error) {
+	s := strings.TrimPrefix(strings.TrimPrefix(value, "+"), "-")
+	negative := strings.HasPrefix(value, "-")
+	if !strings.HasPrefix(s, "P") {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	s = s[1:]
+
+	var d time.Duration
+	inTime := false
+	number := 0
+	digits := false
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			number = number*10 + int(c-'0')
+			digits = true
+			continue
+		case c == 'T':
+			inTime = true
+			continue
+		}
+		if !digits {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		unit := map[rune]time.Duration{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour}[c]
+		if inTime {
+			unit = map[rune]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}[c]
+		}
+		if unit == 0 {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		d += time.Duration(number) * unit
+		number, digits = 0, false
+	}
+	if digits {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
+
+// ReadProperties unfolds continuation lines and splits each content line
+// into its name, parameters and value. Names and parameter names are upper
+// cased, a group prefix such as "item1." is dropped.
+func ReadProperties(r io.Reader) ([]Property, error) {
+	var properties []Property
+	var raw string
+	line := 0
+
+	flush := func() {
+		if raw == "" {
+			return
+		}
+		head, value, ok := splitValue(raw)
+		raw = ""
+		if !ok {
+			return
+		}
+		parts := strings.Split(head, ";")
+		name := parts[0]
+		if dot := strings.LastIndex(name, "."); dot >= 0 {
+			name = name[dot+1:]
+		}
+		p := Property{Name: strings.ToUpper(strings.TrimSpace(name)), Params: make(map[string]string), Value: strings.TrimSpace(value), Line: line}
+		for _, param := range parts[1:] {
+			key, val, _ := strings.Cut(param, "=")
+			p.Params[strings.ToUpper(strings.TrimSpace(key))] = val
+		}
+		properties = append(properties, p)
+	}
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\t")) && raw != "" {
+			raw += text[1:]
+			continue
+		}
+		flush()
+		if strings.TrimSpace(text) != "" {
+			raw, line = text, n
+		}
+	}
+	flush()
+	return properties, scanner.Err()
+}
+
+// splitValue splits a content line at the first colon outside a quoted
+// parameter value
+func splitValue(raw string) (string, string, bool) {
+	quoted := false
+	for i, c := range raw {
+		switch c {
+		case '"':
+			quoted = !quoted
+		case ':':
+			if !quoted {
+				return raw[:i], raw[i+1:], true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// Unescape decodes a TEXT value
+func Unescape(value string) string {
+	return strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, "\n", `\N`, "\n", `\\`, `\`).Replace(value)
+}
@@ -0,0 +1,421 @@
+package ical
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence is a parsed RRULE. BYSETPOS, BYWEEKNO, BYYEARDAY and the sub
+// daily frequencies are not supported.
+type Recurrence struct {
+	Freq       string // DAILY, WEEKLY, MONTHLY or YEARLY
+	Interval   int
+	Count      int
+	Until      time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []time.Month
+	WeekStart  time.Weekday
+}
+
+// WeekdayNum is a BYDAY entry such as MO, 2TU or -1FR. N is zero for every
+// such weekday of the period.
+type WeekdayNum struct {
+	N   int
+	Day time.Weekday
+}
+
+var icalWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// ParseRecurrence parses an RRULE value. A date-only UNTIL is taken as the
+// end of that day in loc.
+func ParseRecurrence(value string, loc *time.Location) (*Recurrence, error) {
+	r := &Recurrence{Interval: 1, WeekStart: time.Monday}
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid RRULE part %q", part)
+		}
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Freq = strings.ToUpper(val)
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(val)
+			if err == nil && r.Interval < 1 {
+				err = fmt.Errorf("interval must be positive")
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(val)
+		case "UNTIL":
+			r.Until, err = parseUntil(val, loc)
+		case "WKST":
+			day, ok := icalWeekdays[strings.ToUpper(val)]
+			if !ok {
+				err = fmt.Errorf("invalid weekday %q", val)
+			}
+			r.WeekStart = day
+		case "BYDAY":
+			for _, d := range strings.Split(val, ",") {
+				d = strings.ToUpper(strings.TrimSpace(d))
+				if len(d) < 2 {
+					err = fmt.Errorf("invalid weekday %q", d)
+					break
+				}
+				day, ok := icalWeekdays[d[len(d)-2:]]
+				if !ok {
+					err = fmt.Errorf("invalid weekday %q", d)
+					break
+				}
+				n := 0
+				if prefix := d[:len(d)-2]; prefix != "" {
+					if n, err = strconv.Atoi(prefix); err != nil {
+						break
+					}
+				}
+				r.ByDay = append(r.ByDay, WeekdayNum{N: n, Day: day})
+			}
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseInts(val)
+		case "BYMONTH":
+			var months []int
+			months, err = parseInts(val)
+			for _, m := range months {
+				r.ByMonth = append(r.ByMonth, time.Month(m))
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid RRULE %s: %v", key, err)
+		}
+	}
+
+	switch r.Freq {
+	case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+	case "":
+		return nil, fmt.Errorf("RRULE without FREQ")
+	default:
+		return nil, fmt.Errorf("unsupported RRULE frequency %s", r.Freq)
+	}
+	return r, nil
+}
+
+func parseUntil(value string, loc *time.Location) (time.Time, error) {
+	if len(value) == 8 {
+		day, err := time.ParseInLocation("20060102", value, loc)
+		return day.AddDate(0, 0, 1).Add(-time.Second), err
+	}
+	if strings.HasSuffix(value, "Z") {
+		return time.Parse("20060102T150405Z", value)
+	}
+	return time.ParseInLocation("20060102T150405", value, loc)
+}
+
+func parseInts(value string) ([]int, error) {
+	var ints []int
+	for _, v := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return nil, err
+		}
+		ints = append(ints, n)
+	}
+	return ints, nil
+}
+
+// maxPeriods bounds the expansion of rules that never match
+const maxPeriods = 100000
+
+// Expand returns the starts of the recurrence of an event starting at
+// start that begin before to, honouring COUNT and UNTIL. Start is always the
+// first instance, even when it doesn't match the rule. Instances keep the
+// wall clock time of start in its location, so a 09:00 meeting stays at
+// 09:00 across DST changes.
+func (r *Recurrence) Expand(start, to time.Time) []time.Time {
+	loc := start.Location()
+	var starts []time.Time
+	// add appends an instance, false once the recurrence is over
+	add := func(c time.Time) bool {
+		if (!r.Until.IsZero() && c.After(r.Until)) || !c.Before(to) {
+			return false
+		}
+		if r.Count > 0 && len(starts) >= r.Count {
+			return false
+		}
+		starts = append(starts, c.In(loc))
+		return true
+	}
+	if !add(start) {
+		return starts
+	}
+	for period := 0; period < maxPeriods; period++ {
+		candidates := r.period(start, period)
+		sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+		for _, c := range candidates {
+			if !c.After(start) {
+				continue
+			}
+			if !add(c) {
+				return starts
+			}
+		}
+	}
+	return starts
+}
+
+// period returns the candidate starts of the n-th period of the rule
+func (r *Recurrence) period(start time.Time, n int) []time.Time {
+	loc := start.Location()
+	y, m, d := start.Date()
+	h, mi, s := start.Clock()
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, h, mi, s, 0, loc)
+	}
+	step := n * r.Interval
+	var candidates []time.Time
+
+	switch r.Freq {
+	case "DAILY":
+		day := at(y, m, d+step)
+		if r.matchMonth(day) && r.matchMonthDay(day) && r.matchWeekday(day) {
+			candidates = append(candidates, day)
+		}
+	case "WEEKLY":
+		offset := (int(start.Weekday()) - int(r.WeekStart) + 7) % 7
+		weekStart := at(y, m, d-offset+7*step)
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []WeekdayNum{{Day: start.Weekday()}}
+		}
+		for _, wd := range days {
+			day := weekStart.AddDate(0, 0, (int(wd.Day)-int(r.WeekStart)+7)%7)
+			day = at(day.Year(), day.Month(), day.Day())
+			if r.matchMonth(day) {
+				candidates = append(candidates, day)
+			}
+		}
+	case "MONTHLY":
+		month := time.Date(y, m+time.Month(step), 1, 0, 0, 0, 0, loc)
+		if r.matchMonth(month) {
+			candidates = append(candidates, r.monthDays(month.Year(), month.Month(), d, at)...)
+		}
+	case "YEARLY":
+		// BYDAY alone counts the weekdays of the whole year, BYMONTHDAY
+		// alone applies to every month
+		months := r.ByMonth
+		switch {
+		case len(months) > 0:
+		case len(r.ByDay) > 0 && len(r.ByMonthDay) == 0:
+			return r.yearDays(y+step, at)
+		case len(r.ByMonthDay) > 0:
+			for month := time.January; month <= time.December; month++ {
+				months = append(months, month)
+			}
+		default:
+			months = []time.Month{m}
+		}
+		for _, month := range months {
+			candidates = append(candidates, r.monthDays(y+step, month, d, at)...)
+		}
+	}
+	return candidates
+}
+
+// monthDays returns the matching days of a month: BYMONTHDAY and BYDAY
+// entries, or the day of the month of DTSTART when there are none. Months
+// too short for that day are skipped.
+func (r *Recurrence) monthDays(y int, m time.Month, startDay int, at func(int, time.Month, int) time.Time) []time.Time {
+	last := time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	var days []int
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, d := range r.ByMonthDay {
+			if d < 0 {
+				d = last + 1 + d
+			}
+			if d >= 1 && d <= last {
+				days = append(days, d)
+			}
+		}
+	case len(r.ByDay) == 0:
+		if startDay <= last {
+			days = append(days, startDay)
+		}
+	}
+
+	if len(r.ByDay) > 0 {
+		var byDay []int
+		first := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC).Weekday()
+		for _, wd := range r.ByDay {
+			// Every day of the month falling on the weekday
+			var matches []int
+			for d := 1 + (int(wd.Day)-int(first)+7)%7; d <= last; d += 7 {
+				matches = append(matches, d)
+			}
+			switch {
+			case wd.N == 0:
+				byDay = append(byDay, matches...)
+			case wd.N > 0 && wd.N <= len(matches):
+				byDay = append(byDay, matches[wd.N-1])
+			case wd.N < 0 && -wd.N <= len(matches):
+				byDay = append(byDay, matches[len(matches)+wd.N])
+			}
+		}
+		if len(r.ByMonthDay) > 0 {
+			// Both given: the month days falling on one of the weekdays
+			var both []int
+			for _, d := range days {
+				for _, b := range byDay {
+					if d == b {
+						both = append(both, d)
+						break
+					}
+				}
+			}
+			byDay = both
+		}
+		days = byDay
+	}
+
+	result := make([]time.Time, 0, len(days))
+	for _, d := range days {
+		result = append(result, at(y, m, d))
+	}
+	return result
+}
+
+// yearDays returns the BYDAY days of a year, an N such as 20MO counting
+// the weekdays of the year
+func (r *Recurrence) yearDays(y int, at func(int, time.Month, int) time.Time) []time.Time {
+	first := time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(y, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	var days []time.Time
+	for _, wd := range r.ByDay {
+		// Every day of the year falling on the weekday, as days of January
+		var matches []int
+		for d := 1 + (int(wd.Day)-int(first.Weekday())+7)%7; d <= last; d += 7 {
+			matches = append(matches, d)
+		}
+		switch {
+		case wd.N == 0:
+			for _, d := range matches {
+				days = append(days, at(y, time.January, d))
+			}
+		case wd.N > 0 && wd.N <= len(matches):
+			days = append(days, at(y, time.January, matches[wd.N-1]))
+		case wd.N < 0 && -wd.N <= len(matches):
+			days = append(days, at(y, time.January, matches[len(matches)+wd.N]))
+		}
+	}
+	return days
+}
+
+func (r *Recurrence) matchMonth(t time.Time) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, m := range r.ByMonth {
+		if t.Month() == m {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Recurrence) matchMonthDay(t time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	last := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, d := range r.ByMonthDay {
+		if d == t.Day() || (d < 0 && last+1+d == t.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Recurrence) matchWeekday(t time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, wd := range r.ByDay {
+		if wd.Day == t.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// Occurrence is one instance of an event
+type Occurrence struct {
+	Event
+	// Recurring is set for instances of an RRULE
+	Recurring bool
+}
+
+// Occurrences expands every event into the instances overlapping
+// [from, to), sorted by start. Recurring events honour EXDATE and
+// instances overridden by a RECURRENCE-ID event with the same UID. An
+// RRULE ParseRecurrence rejects is an error; ParseCalendar already skips
+// such events.
+func Occurrences(events []Event, from, to time.Time) ([]Occurrence, error) {
+	overridden := make(map[string]map[int64]bool)
+	for _, e := range events {
+		if !e.RecurrenceID.IsZero() {
+			if overridden[e.UID] == nil {
+				overridden[e.UID] = make(map[int64]bool)
+			}
+			overridden[e.UID][e.RecurrenceID.Unix()] = true
+		}
+	}
+
+	var occurrences []Occurrence
+	add := func(o Occurrence) {
+		if o.Start.Before(to) && (o.End.After(from) || o.Start.Equal(from)) {
+			occurrences = append(occurrences, o)
+		}
+	}
+	for _, e := range events {
+		if e.Cancelled {
+			continue
+		}
+		if e.RRule == "" || !e.RecurrenceID.IsZero() {
+			add(Occurrence{Event: e})
+			continue
+		}
+
+		rule, err := ParseRecurrence(e.RRule, e.Start.Location())
+		if err != nil {
+			return nil, fmt.Errorf("event %q: %w", e.Summary, err)
+		}
+		excluded := make(map[int64]bool)
+		for _, ex := range e.ExDates {
+			excluded[ex.Unix()] = true
+		}
+		for _, start := range rule.Expand(e.Start, to) {
+			if excluded[start.Unix()] || overridden[e.UID][start.Unix()] {
+				continue
+			}
+			o := Occurrence{Event: e, Recurring: true}
+			o.Start = start
+			if e.AllDay {
+				days := int(e.End.Sub(e.Start).Hours()+12) / 24
+				o.End = start.AddDate(0, 0, days)
+			} else {
+				o.End = start.Add(e.End.Sub(e.Start))
+			}
+			add(o)
+		}
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		return occurrences[i].Start.Before(occurrences[j].Start)
+	})
+	return occurrences, nil
+}
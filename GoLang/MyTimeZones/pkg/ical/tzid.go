@@ -0,0 +1,416 @@
+package ical
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// windowsZones maps the Windows time zone names Outlook and Exchange use as
+// TZID to IANA zones, after the territory "001" entries of CLDR's
+// windowsZones table
+var windowsZones = map[string]string{
+	"Dateline Standard Time":          "Etc/GMT+12",
+	"UTC-11":                          "Etc/GMT+11",
+	"Aleutian Standard Time":          "America/Adak",
+	"Hawaiian Standard Time":          "Pacific/Honolulu",
+	"Alaskan Standard Time":           "America/Anchorage",
+	"Pacific Standard Time (Mexico)":  "America/Tijuana",
+	"Pacific Standard Time":           "America/Los_Angeles",
+	"US Mountain Standard Time":       "America/Phoenix",
+	"Mountain Standard Time (Mexico)": "America/Mazatlan",
+	"Mountain Standard Time":          "America/Denver",
+	"Central America Standard Time":   "America/Guatemala",
+	"Central Standard Time":           "America/Chicago",
+	"Central Standard Time (Mexico)":  "America/Mexico_City",
+	"Canada Central Standard Time":    "America/Regina",
+	"SA Pacific Standard Time":        "America/Bogota",
+	"Eastern Standard Time (Mexico)":  "America/Cancun",
+	"Eastern Standard Time":           "America/New_York",
+	"US Eastern Standard Time":        "America/Indiana/Indianapolis",
+	"Cuba Standard Time":              "America/Havana",
+	"Haiti Standard Time":             "America/Port-au-Prince",
+	"Venezuela Standard Time":         "America/Caracas",
+	"Paraguay Standard Time":          "America/Asuncion",
+	"Atlantic Standard Time":          "America/Halifax",
+	"Central Brazilian Standard Time": "America/Cuiaba",
+	"SA Western Standard Time":        "America/La_Paz",
+	"Pacific SA Standard Time":        "America/Santiago",
+	"Newfoundland Standard Time":      "America/St_Johns",
+	"E. South America Standard Time":  "America/Sao_Paulo",
+	"SA Eastern Standard Time":        "America/Cayenne",
+	"Argentina Standard Time":         "America/Argentina/Buenos_Aires",
+	"Greenland Standard Time":         "America/Nuuk",
+	"Montevideo Standard Time":        "America/Montevideo",
+	"UTC-02":                          "Etc/GMT+2",
+	"Azores Standard Time":            "Atlantic/Azores",
+	"Cape Verde Standard Time":        "Atlantic/Cape_Verde",
+	"UTC":                             "Etc/UTC",
+	"GMT Standard Time":               "Europe/London",
+	"Greenwich Standard Time":         "Atlantic/Reykjavik",
+	"Morocco Standard Time":           "Africa/Casablanca",
+	"W. Europe Standard Time":         "Europe/Berlin",
+	"Central Europe Standard Time":    "Europe/Budapest",
+	"Romance Standard Time":           "Europe/Paris",
+	"Central European Standard Time":  "Europe/Warsaw",
+	"W. Central Africa Standard Time": "Africa/Lagos",
+	"Jordan Standard Time":            "Asia/Amman",
+	"GTB Standard Time":               "Europe/Bucharest",
+	"Middle East Standard Time":       "Asia/Beirut",
+	"Egypt Standard Time":             "Africa/Cairo",
+	"E. Europe Standard Time":         "Europe/Chisinau",
+	"Syria Standard Time":             "Asia/Damascus",
+	"South Africa Standard Time":      "Africa/Johannesburg",
+	"FLE Standard Time":               "Europe/Kyiv",
+	"Israel Standard Time":            "Asia/Jerusalem",
+	"Arabic Standard Time":            "Asia/Baghdad",
+	"Turkey Standard Time":            "Europe/Istanbul",
+	"Arab Standard Time":              "Asia/Riyadh",
+	"Belarus Standard Time":           "Europe/Minsk",
+	"Russian Standard Time":           "Europe/Moscow",
+	"E. Africa Standard Time":         "Africa/Nairobi",
+	"Iran Standard Time":              "Asia/Tehran",
+	"Arabian Standard Time":           "Asia/Dubai",
+	"Azerbaijan Standard Time":        "Asia/Baku",
+	"Georgian Standard Time":          "Asia/Tbilisi",
+	"Caucasus Standard Time":          "Asia/Yerevan",
+	"Mauritius Standard Time":         "Indian/Mauritius",
+	"Afghanistan Standard Time":       "Asia/Kabul",
+	"West Asia Standard Time":         "Asia/Tashkent",
+	"Ekaterinburg Standard Time":      "Asia/Yekaterinburg",
+	"Pakistan Standard Time":          "Asia/Karachi",
+	"India Standard Time":             "Asia/Kolkata",
+	"Sri Lanka Standard Time":         "Asia/Colombo",
+	"Nepal Standard Time":             "Asia/Kathmandu",
+	"Central Asia Standard Time":      "Asia/Almaty",
+	"Bangladesh Standard Time":        "Asia/Dhaka",
+	"Myanmar Standard Time":           "Asia/Yangon",
+	"SE Asia Standard Time":           "Asia/Bangkok",
+	"N. Central Asia Standard Time":   "Asia/Novosibirsk",
+	"China Standard Time":             "Asia/Shanghai",
+	"North Asia Standard Time":        "Asia/Krasnoyarsk",
+	"Singapore Standard Time":         "Asia/Singapore",
+	"W. Australia Standard Time":      "Australia/Perth",
+	"Taipei Standard Time":            "Asia/Taipei",
+	"North Asia East Standard Time":   "Asia/Irkutsk",
+	"Tokyo Standard Time":             "Asia/Tokyo",
+	"Korea Standard Time":             "Asia/Seoul",
+	"Yakutsk Standard Time":           "Asia/Yakutsk",
+	"Cen. Australia Standard Time":    "Australia/Adelaide",
+	"AUS Central Standard Time":       "Australia/Darwin",
+	"E. Australia Standard Time":      "Australia/Brisbane",
+	"AUS Eastern Standard Time":       "Australia/Sydney",
+	"West Pacific Standard Time":      "Pacific/Port_Moresby",
+	"Tasmania Standard Time":          "Australia/Hobart",
+	"Vladivostok Standard Time":       "Asia/Vladivostok",
+	"Magadan Standard Time":           "Asia/Magadan",
+	"Central Pacific Standard Time":   "Pacific/Guadalcanal",
+	"New Zealand Standard Time":       "Pacific/Auckland",
+	"UTC+12":                          "Etc/GMT-12",
+	"Fiji Standard Time":              "Pacific/Fiji",
+	"Tonga Standard Time":             "Pacific/Tongatapu",
+	"Samoa Standard Time":             "Pacific/Apia",
+	"Line Islands Standard Time":      "Pacific/Kiritimati",
+}
+
+// loadZone loads an IANA zone, a Windows zone name, or the IANA zone at
+// the end of a prefixed TZID such as "/mozilla.org/20050126_1/Europe/Berlin"
+func loadZone(tzid string) (*time.Location, bool) {
+	tzid = strings.Trim(strings.TrimSpace(tzid), `"`)
+	if tzid == "" {
+		return nil, false
+	}
+	if iana, ok := windowsZones[tzid]; ok {
+		tzid = iana
+	}
+	if loc, err := time.LoadLocation(tzid); err == nil {
+		return loc, true
+	}
+	parts := strings.Split(strings.Trim(tzid, "/"), "/")
+	for n := max(len(parts)-3, 1); n < len(parts); n++ {
+		if loc, err := time.LoadLocation(strings.Join(parts[n:], "/")); err == nil {
+			return loc, true
+		}
+	}
+	return nil, false
+}
+
+// vtimezone is a VTIMEZONE component: the STANDARD and DAYLIGHT onsets of a
+// TZID the calendar defines itself
+type vtimezone struct {
+	tzid string
+	// hint is the X-LIC-LOCATION some producers add
+	hint   string
+	onsets []onset
+}
+
+// onset is a STANDARD or DAYLIGHT sub-component. Start and the RDATEs are
+// wall clock times in the offset in force before the onset, kept in UTC.
+type onset struct {
+	start      time.Time
+	offsetFrom int
+	offsetTo   int
+	rrule      string
+	rdates     []time.Time
+}
+
+// readTimezones collects the VTIMEZONE components of a calendar, by TZID
+func readTimezones(properties []Property) (map[string]*vtimezone, error) {
+	timezones := make(map[string]*vtimezone)
+	var tz *vtimezone
+	var current *onset
+	for _, p := range properties {
+		begin := p.Name == "BEGIN"
+		end := p.Name == "END"
+		switch {
+		case begin && strings.EqualFold(p.Value, "VTIMEZONE"):
+			tz = &vtimezone{}
+		case tz == nil:
+			continue
+		case end && strings.EqualFold(p.Value, "VTIMEZONE"):
+			if tz.tzid != "" {
+				timezones[tz.tzid] = tz
+			}
+			tz = nil
+		case begin && (strings.EqualFold(p.Value, "STANDARD") || strings.EqualFold(p.Value, "DAYLIGHT")):
+			current = &onset{}
+		case end && (strings.EqualFold(p.Value, "STANDARD") || strings.EqualFold(p.Value, "DAYLIGHT")):
+			if current != nil && !current.start.IsZero() {
+				tz.onsets = append(tz.onsets, *current)
+			}
+			current = nil
+		case p.Name == "TZID":
+			tz.tzid = strings.Trim(p.Value, `"`)
+		case p.Name == "X-LIC-LOCATION":
+			tz.hint = p.Value
+		case current == nil:
+			continue
+		case p.Name == "DTSTART":
+			t, err := time.Parse("20060102T150405", strings.TrimSuffix(p.Value, "Z"))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid date-time %q", p.Line, p.Value)
+			}
+			current.start = t
+		case p.Name == "TZOFFSETFROM", p.Name == "TZOFFSETTO":
+			offset, err := parseOffset(p.Value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", p.Line, err)
+			}
+			if p.Name == "TZOFFSETFROM" {
+				current.offsetFrom = offset
+			} else {
+				current.offsetTo = offset
+			}
+		case p.Name == "RRULE":
+			current.rrule = p.Value
+		case p.Name == "RDATE":
+			for _, value := range strings.Split(p.Value, ",") {
+				t, err := time.Parse("20060102T150405", strings.TrimSuffix(strings.TrimSpace(value), "Z"))
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid date-time %q", p.Line, value)
+				}
+				current.rdates = append(current.rdates, t)
+			}
+		}
+	}
+	return timezones, nil
+}
+
+// parseOffset parses a UTC offset such as +0100, -0530 or +013000
+func parseOffset(value string) (int, error) {
+	sign := 1
+	switch {
+	case strings.HasPrefix(value, "-"):
+		sign = -1
+	case !strings.HasPrefix(value, "+"):
+		return 0, fmt.Errorf("invalid UTC offset %q", value)
+	}
+	digits := value[1:]
+	if len(digits) != 4 && len(digits) != 6 {
+		return 0, fmt.Errorf("invalid UTC offset %q", value)
+	}
+	seconds := 0
+	for n, unit := range []int{3600, 60, 1} {
+		if 2*n >= len(digits) {
+			break
+		}
+		v, err := strconv.Atoi(digits[2*n : 2*n+2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid UTC offset %q", value)
+		}
+		seconds += v * unit
+	}
+	return sign * seconds, nil
+}
+
+// offsetAt returns the UTC offset in seconds the VTIMEZONE gives instant t:
+// that of the latest onset at or before t
+func (v *vtimezone) offsetAt(t time.Time) int {
+	var latest time.Time
+	offset, found := 0, false
+	for _, o := range v.onsets {
+		last, ok := o.lastBefore(t)
+		if ok && (!found || last.After(latest)) {
+			latest, offset, found = last, o.offsetTo, true
+		}
+	}
+	if found {
+		return offset
+	}
+	// Before every onset, the offset the earliest one changes from
+	earliest := v.onsets[0]
+	for _, o := range v.onsets[1:] {
+		if o.start.Before(earliest.start) {
+			earliest = o
+		}
+	}
+	return earliest.offsetFrom
+}
+
+// lastBefore returns the instant of the last onset at or before t
+func (o onset) lastBefore(t time.Time) (time.Time, bool) {
+	// Onsets are wall clock times in offsetFrom
+	wall := t.Add(time.Duration(o.offsetFrom) * time.Second)
+	starts := append([]time.Time{o.start}, o.rdates...)
+	if o.rrule != "" {
+		if rule, err := ParseRecurrence(o.rrule, time.UTC); err == nil {
+			starts = append(starts, rule.Expand(o.start, wall.Add(time.Second))...)
+		}
+	}
+	var last time.Time
+	found := false
+	for _, s := range starts {
+		if !s.After(wall) && (!found || s.After(last)) {
+			last, found = s, true
+		}
+	}
+	return last.Add(-time.Duration(o.offsetFrom) * time.Second), found
+}
+
+// location returns a zone following the VTIMEZONE's rules in the year of
+// now: its X-LIC-LOCATION, a fixed zone when it has no DST, or the first
+// well known zone whose offset agrees with it on every day of the year
+func (v *vtimezone) location(now time.Time) (*time.Location, bool) {
+	if loc, ok := loadZone(v.hint); ok {
+		return loc, true
+	}
+	if len(v.onsets) == 0 {
+		return nil, false
+	}
+
+	start := time.Date(now.Year(), 1, 1, 12, 0, 0, 0, time.UTC)
+	var days []time.Time
+	var offsets []int
+	fixed := true
+	for day := start; day.Year() == now.Year(); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+		offsets = append(offsets, v.offsetAt(day))
+		fixed = fixed && offsets[len(offsets)-1] == offsets[0]
+	}
+	if fixed {
+		return time.FixedZone(v.tzid, offsets[0]), true
+	}
+
+	for _, name := range candidateZones() {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			continue
+		}
+		match := true
+		for n, day := range days {
+			if _, offset := day.In(loc).Zone(); offset != offsets[n] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return loc, true
+		}
+	}
+	return nil, false
+}
+
+// candidateZones returns the IANA zones of windowsZones, sorted, so a
+// VTIMEZONE matching several resolves the same way every time
+func candidateZones() []string {
+	seen := make(map[string]bool)
+	var zones []string
+	for _, zone := range windowsZones {
+		if !seen[zone] {
+			seen[zone] = true
+			zones = append(zones, zone)
+		}
+	}
+	sort.Strings(zones)
+	return zones
+}
+
+// zoneResolver turns the TZID of the date-times of a calendar into zones,
+// once per TZID
+type zoneResolver struct {
+	local     *time.Location
+	timezones map[string]*vtimezone
+	resolved  map[string]*time.Location
+	now       time.Time
+}
+
+// location resolves a TZID: an IANA or Windows zone name, or a VTIMEZONE of
+// the calendar. The bool is false when the TZID can't be resolved.
+func (z *zoneResolver) location(tzid string) (*time.Location, bool) {
+	tzid = strings.Trim(tzid, `"`)
+	if loc, ok := z.resolved[tzid]; ok {
+		return loc, loc != nil
+	}
+	loc, ok := loadZone(tzid)
+	if !ok {
+		if tz, found := z.timezones[tzid]; found {
+			loc, ok = tz.location(z.now)
+		}
+	}
+	if !ok {
+		loc = nil
+	}
+	z.resolved[tzid] = loc
+	return loc, ok
+}
+
+// unknownZoneError reports a date-time whose TZID can't be resolved
+type unknownZoneError struct {
+	tzid string
+}
+
+func (e *unknownZoneError) Error() string {
+	return fmt.Sprintf("unknown time zone %q", e.tzid)
+}
+
+// parseTime parses a DATE or DATE-TIME property value. Floating times are
+// in the local zone; a TZID that can't be resolved is an
+// *unknownZoneError. The bool reports a DATE value.
+func (z *zoneResolver) parseTime(p Property) (time.Time, bool, error) {
+	value := p.Value
+	if strings.EqualFold(p.Params["VALUE"], "DATE") || len(value) == 8 {
+		t, err := time.Parse("20060102", value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("line %d: invalid date %q", p.Line, value)
+		}
+		return t, true, nil
+	}
+
+	loc := z.local
+	if strings.HasSuffix(value, "Z") {
+		value, loc = strings.TrimSuffix(value, "Z"), time.UTC
+	} else if tzid := p.Params["TZID"]; tzid != "" {
+		l, ok := z.location(tzid)
+		if !ok {
+			return time.Time{}, false, &unknownZoneError{tzid: strings.Trim(tzid, `"`)}
+		}
+		loc = l
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("line %d: invalid date-time %q", p.Line, p.Value)
+	}
+	return t, false, nil
+}
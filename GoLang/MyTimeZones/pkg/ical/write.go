@@ -0,0 +1,181 @@
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ProductID identifies the application in written calendars
+const ProductID = "-//MyTimeZones//MyTimeZones//EN"
+
+// Write writes a calendar holding events. Timed events are written in the
+// location of their start time, with a VTIMEZONE describing it around the
+// event; UTC starts are written as UTC.
+func Write(out io.Writer, events ...Event) error {
+	w := &lineWriter{w: bufio.NewWriter(out)}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + ProductID)
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+
+	// One VTIMEZONE per location, spanning every event using it
+	type span struct {
+		loc        *time.Location
+		start, end time.Time
+	}
+	var zones []*span
+	byName := make(map[string]*span)
+	for _, e := range events {
+		loc := e.Start.Location()
+		if e.AllDay || loc == time.UTC {
+			continue
+		}
+		s, ok := byName[loc.String()]
+		if !ok {
+			s = &span{loc: loc, start: e.Start, end: e.End}
+			byName[loc.String()] = s
+			zones = append(zones, s)
+		}
+		if e.Start.Before(s.start) {
+			s.start = e.Start
+		}
+		if e.End.After(s.end) {
+			s.end = e.End
+		}
+	}
+	for _, s := range zones {
+		writeTimezone(w, s.loc, s.start, s.end)
+	}
+
+	for _, e := range events {
+		w.line("BEGIN:VEVENT")
+		w.line("UID:" + escape(e.UID))
+		w.line("DTSTAMP:" + time.Now().UTC().Format("20060102T150405Z"))
+		w.line(formatTime("DTSTART", e.Start, e.AllDay))
+		w.line(formatTime("DTEND", e.End, e.AllDay))
+		w.line("SUMMARY:" + escape(e.Summary))
+		if e.Description != "" {
+			w.line("DESCRIPTION:" + escape(e.Description))
+		}
+		w.line("END:VEVENT")
+	}
+	w.line("END:VCALENDAR")
+	return w.flush()
+}
+
+func formatTime(name string, t time.Time, allDay bool) string {
+	switch {
+	case allDay:
+		return name + ";VALUE=DATE:" + t.Format("20060102")
+	case t.Location() == time.UTC:
+		return name + ":" + t.Format("20060102T150405Z")
+	default:
+		return name + ";TZID=" + t.Location().String() + ":" + t.Format("20060102T150405")
+	}
+}
+
+// writeTimezone describes loc from a year before start to a year after
+// end. Each offset change is its own observance, so no recurrence rules
+// are needed and the description matches Go's zone data exactly.
+func writeTimezone(w *lineWriter, loc *time.Location, start, end time.Time) {
+	from := start.AddDate(-1, 0, 0)
+	until := end.AddDate(1, 0, 0)
+
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + loc.String())
+
+	// The observance in effect at from, then every change until until
+	at := from.In(loc)
+	onset, next := at.ZoneBounds()
+	name, offset := at.Zone()
+	previous := offset
+	if !onset.IsZero() {
+		before := onset.Add(-time.Second).In(loc)
+		_, previous = before.Zone()
+	}
+	for {
+		writeObservance(w, at.IsDST(), onset, name, previous, offset)
+		if next.IsZero() || next.After(until) {
+			break
+		}
+		at = next.In(loc)
+		previous = offset
+		name, offset = at.Zone()
+		onset, next = at.ZoneBounds()
+	}
+	w.line("END:VTIMEZONE")
+}
+
+func writeObservance(w *lineWriter, dst bool, onset time.Time, name string, from, to int) {
+	kind := "STANDARD"
+	if dst {
+		kind = "DAYLIGHT"
+	}
+	// DTSTART is the local time of the onset in the offset it replaces
+	start := "19700101T000000"
+	if !onset.IsZero() {
+		start = onset.In(time.FixedZone("", from)).Format("20060102T150405")
+	}
+	w.line("BEGIN:" + kind)
+	w.line("DTSTART:" + start)
+	w.line("TZOFFSETFROM:" + formatOffset(from))
+	w.line("TZOFFSETTO:" + formatOffset(to))
+	if !strings.HasPrefix(name, "+") && !strings.HasPrefix(name, "-") {
+		w.line("TZNAME:" + escape(name))
+	}
+	w.line("END:" + kind)
+}
+
+// formatOffset formats an offset in seconds as +HHMM, or +HHMMSS when needed
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+	s := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds%3600/60)
+	if seconds%60 != 0 {
+		s += fmt.Sprintf("%02d", seconds%60)
+	}
+	return s
+}
+
+func escape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(value)
+}
+
+// lineWriter writes CRLF terminated content lines folded at 75 octets,
+// without splitting UTF-8 sequences
+type lineWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (l *lineWriter) line(s string) {
+	if l.err != nil {
+		return
+	}
+	limit := 75
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		_, l.err = l.w.WriteString(s[:cut] + "\r\n ")
+		s = s[cut:]
+		limit = 74 // the leading space counts
+	}
+	if l.err == nil {
+		_, l.err = l.w.WriteString(s + "\r\n")
+	}
+}
+
+func (l *lineWriter) flush() error {
+	if l.err != nil {
+		return l.err
+	}
+	return l.w.Flush()
+}
@@ -0,0 +1,99 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// csvHeaders maps accepted header names to the column they name
+var csvHeaders = map[string]string{
+	"name":      "name",
+	"full name": "name",
+	"email":     "email",
+	"e-mail":    "email",
+	"mail":      "email",
+	"zone":      "zone",
+	"timezone":  "zone",
+	"time zone": "zone",
+	"tz":        "zone",
+}
+
+// ParseCSV reads a name, email, zone roster. A header row naming the
+// columns may reorder them; without one the columns are taken in that order.
+func ParseCSV(r io.Reader, source string) (Result, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	columns := map[string]int{"name": 0, "email": 1, "zone": 2}
+	var result Result
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				result.problem(source, parseErr.Line, "%v", parseErr.Err)
+				continue
+			}
+			return Result{}, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		if first {
+			if header, ok := parseHeader(record); ok {
+				columns = header
+				continue
+			}
+		}
+
+		field := func(name string) string {
+			if n, ok := columns[name]; ok && n < len(record) {
+				return strings.TrimSpace(record[n])
+			}
+			return ""
+		}
+		if strings.Join(record, "") == "" {
+			continue
+		}
+
+		person := timezone.Person{Name: field("name"), Email: field("email")}
+		if person.Name == "" {
+			person.Name = person.Email
+		}
+		if person.Name == "" {
+			result.problem(source, line, "name is empty")
+			continue
+		}
+		zone, err := ResolveZone(field("zone"))
+		if err != nil {
+			result.zoneProblem(source, line, person, err)
+			continue
+		}
+		person.Zone = zone
+		result.People = append(result.People, person)
+	}
+	return result, nil
+}
+
+// parseHeader returns the column of each known header, when the record is
+// a header row naming at least the name and zone columns
+func parseHeader(record []string) (map[string]int, bool) {
+	columns := make(map[string]int)
+	for n, cell := range record {
+		if column, ok := csvHeaders[strings.ToLower(strings.TrimSpace(cell))]; ok {
+			if _, seen := columns[column]; !seen {
+				columns[column] = n
+			}
+		}
+	}
+	_, hasName := columns["name"]
+	_, hasZone := columns["zone"]
+	return columns, hasName && hasZone
+}
@@ -0,0 +1,238 @@
+// Package importer reads team rosters from CSV and vCard files and merges
+// them into the configured people.
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// Problem is a record that could not be imported
+type Problem struct {
+	Source  string
+	Line    int
+	Message string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s:%d: %s", p.Source, p.Line, p.Message)
+}
+
+// Choice is a record whose zone is a UTC offset several zones use. The
+// person is imported once the user picks one of Zones.
+type Choice struct {
+	Source string
+	Line   int
+	Person timezone.Person
+	Offset string
+	Zones  []string
+}
+
+func (c Choice) String() string {
+	return fmt.Sprintf("%s:%d: %s: offset %s, pick one of %d zones", c.Source, c.Line, c.Person.Name, c.Offset, len(c.Zones))
+}
+
+// Result holds the people read from a file, the records waiting for the
+// user to pick a zone and the records that were skipped
+type Result struct {
+	People   []timezone.Person
+	Choices  []Choice
+	Problems []Problem
+}
+
+func (r *Result) problem(source string, line int, format string, args ...interface{}) {
+	r.Problems = append(r.Problems, Problem{Source: source, Line: line, Message: fmt.Sprintf(format, args...)})
+}
+
+// zoneProblem records a person whose zone didn't resolve: a choice when it
+// is an offset several zones use, a problem otherwise
+func (r *Result) zoneProblem(source string, line int, person timezone.Person, err error) {
+	var ambiguous *AmbiguousOffsetError
+	if errors.As(err, &ambiguous) {
+		r.Choices = append(r.Choices, Choice{Source: source, Line: line, Person: person, Offset: ambiguous.Offset, Zones: ambiguous.Zones})
+		return
+	}
+	r.problem(source, line, "%s: %v", person.Name, err)
+}
+
+// Pick imports the people waiting for a zone whose offset has one in
+// zones, keyed by OffsetKey. A picked zone that doesn't use the offset
+// leaves the record skipped, the other choices are kept.
+func (r *Result) Pick(zones map[string]string) {
+	var left []Choice
+	for _, choice := range r.Choices {
+		key, _ := OffsetKey(choice.Offset)
+		zone, ok := zones[key]
+		switch {
+		case !ok:
+			left = append(left, choice)
+		case !slices.Contains(choice.Zones, zone):
+			r.problem(choice.Source, choice.Line, "%s: %s doesn't use offset %s", choice.Person.Name, zone, choice.Offset)
+		default:
+			person := choice.Person
+			person.Zone = zone
+			r.People = append(r.People, person)
+		}
+	}
+	r.Choices = left
+}
+
+// ParseFile reads a roster, picking the parser from the file extension:
+// .csv, or .vcf and .vcard
+func ParseFile(path string) (Result, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Result{}, err
+	}
+	defer file.Close()
+
+	source := filepath.Base(path)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ParseCSV(file, source)
+	case ".vcf", ".vcard":
+		return ParseVCard(file, source)
+	default:
+		return Result{}, fmt.Errorf("%s: unsupported file type, expected .csv or .vcf", source)
+	}
+}
+
+// ParseFiles reads several rosters into a single result
+func ParseFiles(paths []string) (Result, error) {
+	var all Result
+	for _, path := range paths {
+		r, err := ParseFile(path)
+		if err != nil {
+			return Result{}, err
+		}
+		all.People = append(all.People, r.People...)
+		all.Choices = append(all.Choices, r.Choices...)
+		all.Problems = append(all.Problems, r.Problems...)
+	}
+	return all, nil
+}
+
+// ChangeKind tells what merging an imported person does to the config
+type ChangeKind string
+
+const (
+	Add       ChangeKind = "add"
+	Update    ChangeKind = "update"
+	Unchanged ChangeKind = "unchanged"
+)
+
+// Change is the preview of merging one imported person
+type Change struct {
+	Kind   ChangeKind
+	Person timezone.Person
+	// Old is the configured person an update replaces
+	Old *timezone.Person
+	// index of Old in the configured people
+	index int
+}
+
+func (c Change) String() string {
+	switch c.Kind {
+	case Add:
+		return fmt.Sprintf("+ %s", describe(c.Person))
+	case Update:
+		return fmt.Sprintf("~ %s (was %s)", describe(c.Person), describe(*c.Old))
+	default:
+		return fmt.Sprintf("= %s", describe(c.Person))
+	}
+}
+
+func describe(p timezone.Person) string {
+	if p.Email != "" {
+		return fmt.Sprintf("%s <%s> %s", p.Name, p.Email, p.Zone)
+	}
+	return fmt.Sprintf("%s %s", p.Name, p.Zone)
+}
+
+// samePerson matches people by email when both have one, by name otherwise
+func samePerson(a, b timezone.Person) bool {
+	if a.Email != "" && b.Email != "" {
+		return strings.EqualFold(a.Email, b.Email)
+	}
+	return strings.EqualFold(strings.TrimSpace(a.Name), strings.TrimSpace(b.Name))
+}
+
+// Diff previews merging imported people into the configured ones. A person
+// already configured keeps their working hours and notes, only the name,
+// email and zone are updated. When a file lists someone twice the last
+// record wins.
+func Diff(existing, imported []timezone.Person) []Change {
+	var changes []Change
+	var added []int              // changes adding a person
+	updated := make(map[int]int) // existing index -> change
+
+	for _, p := range imported {
+		if n := findPerson(existing, p); n >= 0 {
+			old := existing[n]
+			merged := old
+			merged.Name = p.Name
+			merged.Zone = p.Zone
+			if p.Email != "" {
+				merged.Email = p.Email
+			}
+			kind := Update
+			if merged.Name == old.Name && merged.Email == old.Email && merged.Zone == old.Zone {
+				kind = Unchanged
+			}
+			change := Change{Kind: kind, Person: merged, Old: &existing[n], index: n}
+			if c, ok := updated[n]; ok {
+				changes[c] = change
+			} else {
+				updated[n] = len(changes)
+				changes = append(changes, change)
+			}
+			continue
+		}
+
+		if c := slices.IndexFunc(added, func(c int) bool { return samePerson(changes[c].Person, p) }); c >= 0 {
+			changes[added[c]].Person = p
+			continue
+		}
+		added = append(added, len(changes))
+		changes = append(changes, Change{Kind: Add, Person: p, index: -1})
+	}
+	return changes
+}
+
+func findPerson(people []timezone.Person, p timezone.Person) int {
+	for n, q := range people {
+		if samePerson(q, p) {
+			return n
+		}
+	}
+	return -1
+}
+
+// Merge applies the changes returned by Diff to the configured people
+func Merge(existing []timezone.Person, changes []Change) []timezone.Person {
+	people := append([]timezone.Person(nil), existing...)
+	for _, c := range changes {
+		switch c.Kind {
+		case Add:
+			people = append(people, c.Person)
+		case Update:
+			people[c.index] = c.Person
+		}
+	}
+	return people
+}
+
+// Counts returns how many changes of each kind a preview holds
+func Counts(changes []Change) map[ChangeKind]int {
+	counts := make(map[ChangeKind]int)
+	for _, c := range changes {
+		counts[c.Kind]++
+	}
+	return counts
+}
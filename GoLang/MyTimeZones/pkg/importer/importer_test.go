@@ -0,0 +1,191 @@
+package importer
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+func TestResolveZone(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"Europe/Lisbon", "Europe/Lisbon", false},
+		{"europe/lisbon", "Europe/Lisbon", false},
+		{"New York", "America/New_York", false},
+		{"Asia/Calcutta", "Asia/Kolkata", false},
+		{"+05:45", "Asia/Kathmandu", false},
+		{"utc", "UTC", false},
+		{"+15:00", "", true},
+		{"Mars/Olympus", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ResolveZone(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ResolveZone(%q) = %q, %v, want %q, error %v", tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestResolveZone_AmbiguousOffset(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string // among the zones offered
+	}{
+		{"+05:30", []string{"Asia/Colombo", "Asia/Kolkata"}},
+		// Berlin is +01:00 in winter only, it is still offered
+		{"+01:00", []string{"Europe/Berlin", "Africa/Lagos"}},
+		{"UTC-5", []string{"America/New_York", "America/Bogota"}},
+		{"+00:00", []string{"UTC", "Europe/London"}},
+	}
+	for _, tt := range tests {
+		_, err := ResolveZone(tt.value)
+		var ambiguous *AmbiguousOffsetError
+		if !errors.As(err, &ambiguous) {
+			t.Errorf("ResolveZone(%q) error = %v, want an *AmbiguousOffsetError", tt.value, err)
+			continue
+		}
+		for _, zone := range tt.want {
+			if !slices.Contains(ambiguous.Zones, zone) {
+				t.Errorf("ResolveZone(%q) offers %v, want %s among them", tt.value, ambiguous.Zones, zone)
+			}
+		}
+		for _, zone := range ambiguous.Zones {
+			if strings.HasPrefix(zone, "Etc/") {
+				t.Errorf("ResolveZone(%q) offers fixed zone %s", tt.value, zone)
+			}
+		}
+	}
+}
+
+func TestParseFile_CSV(t *testing.T) {
+	result, err := ParseFile(filepath.Join("testdata", "team.csv"))
+	if err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+
+	want := []timezone.Person{
+		{Name: "Ana Silva", Email: "ana@example.com", Zone: "Europe/Lisbon"},
+		{Name: "Kenji Sato", Email: "kenji@example.com", Zone: "Asia/Tokyo"},
+	}
+	if !reflect.DeepEqual(result.People, want) {
+		t.Errorf("People = %+v, want %+v", result.People, want)
+	}
+	// The offsets of Raj and Li are shared by several zones
+	if len(result.Choices) != 2 || result.Choices[0].Line != 4 || result.Choices[1].Line != 5 ||
+		!slices.Contains(result.Choices[0].Zones, "Asia/Kolkata") || !slices.Contains(result.Choices[1].Zones, "Asia/Shanghai") {
+		t.Errorf("Choices = %v, want lines 4 and 5", result.Choices)
+	}
+	if len(result.Problems) != 1 || result.Problems[0].Line != 7 {
+		t.Errorf("Problems = %v, want line 7", result.Problems)
+	}
+}
+
+func TestResult_Pick(t *testing.T) {
+	result, err := ParseFile(filepath.Join("testdata", "team.csv"))
+	if err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+
+	// Raj wrote UTC+5:30 and Li GMT+8, picked here as +05:30 and +08:00
+	result.Pick(map[string]string{"+05:30": "Asia/Kolkata", "+08:00": "Europe/Berlin"})
+	if len(result.People) != 3 || result.People[2] != (timezone.Person{Name: "Raj Patel", Email: "raj@example.com", Zone: "Asia/Kolkata"}) {
+		t.Errorf("People = %+v, want Raj Patel in Asia/Kolkata added", result.People)
+	}
+	if len(result.Choices) != 0 {
+		t.Errorf("Choices = %v, want none left", result.Choices)
+	}
+	// Berlin never uses +08:00
+	if len(result.Problems) != 2 || result.Problems[1].Line != 5 {
+		t.Errorf("Problems = %v, want Li Wei's line 5 skipped", result.Problems)
+	}
+}
+
+func TestOffsetKey(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   string
+		wantOK bool
+	}{
+		{"+01:00", "+01:00", true},
+		{"UTC+1", "+01:00", true},
+		{"GMT -0530", "-05:30", true},
+		{"Europe/Berlin", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := OffsetKey(tt.value)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("OffsetKey(%q) = %q, %v, want %q, %v", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestParseFile_VCard(t *testing.T) {
+	result, err := ParseFile(filepath.Join("testdata", "team.vcf"))
+	if err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+
+	want := []timezone.Person{
+		{Name: "Ana Silva", Email: "ana@example.com", Zone: "Europe/Lisbon"},
+	}
+	if !reflect.DeepEqual(result.People, want) {
+		t.Errorf("People = %+v, want %+v", result.People, want)
+	}
+	// -05:00 is shared, and the fixed EST zone doesn't settle it
+	if len(result.Choices) != 1 || result.Choices[0].Person.Name != "Tom Brown" || !slices.Contains(result.Choices[0].Zones, "America/New_York") {
+		t.Errorf("Choices = %v, want Tom Brown", result.Choices)
+	}
+	if len(result.Problems) != 1 {
+		t.Errorf("Problems = %v, want the card without TZ", result.Problems)
+	}
+}
+
+func TestDiffAndMerge(t *testing.T) {
+	hours := &timezone.WorkingHours{Start: "08:00", End: "16:00"}
+	existing := []timezone.Person{
+		{Name: "Ana Silva", Email: "ana@example.com", Zone: "Europe/Lisbon", Notes: "design"},
+		{Name: "Kenji", Zone: "Asia/Tokyo", WorkingHours: hours},
+	}
+	imported := []timezone.Person{
+		{Name: "Ana Silva", Email: "ANA@example.com", Zone: "Europe/Lisbon"},
+		{Name: "kenji", Email: "kenji@example.com", Zone: "Asia/Seoul"},
+		{Name: "Li Wei", Zone: "Asia/Shanghai"},
+		{Name: "Li Wei", Zone: "Asia/Singapore"},
+	}
+
+	changes := Diff(existing, imported)
+	kinds := make([]ChangeKind, len(changes))
+	for n, c := range changes {
+		kinds[n] = c.Kind
+	}
+	if want := []ChangeKind{Update, Update, Add}; !reflect.DeepEqual(kinds, want) {
+		t.Fatalf("Diff() kinds = %v, want %v", kinds, want)
+	}
+
+	got := Merge(existing, changes)
+	want := []timezone.Person{
+		{Name: "Ana Silva", Email: "ANA@example.com", Zone: "Europe/Lisbon", Notes: "design"},
+		{Name: "kenji", Email: "kenji@example.com", Zone: "Asia/Seoul", WorkingHours: hours},
+		{Name: "Li Wei", Zone: "Asia/Singapore"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %+v, want %+v", got, want)
+	}
+	if existing[1].Zone != "Asia/Tokyo" {
+		t.Error("Merge() modified the existing people")
+	}
+
+	if changes := Diff(got, imported[:2]); Counts(changes)[Unchanged] != 2 {
+		t.Errorf("Diff() of merged people = %v, want everything unchanged", changes)
+	}
+}
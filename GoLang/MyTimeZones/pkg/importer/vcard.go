@@ -0,0 +1,104 @@
+package importer
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/yourusername/MyTimeZones/pkg/ical"
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// ParseVCard reads every card with a TZ property. The TZ value may be an
+// IANA name, a fixed offset, or the vCard 3 "offset; name; place" form.
+func ParseVCard(r io.Reader, source string) (Result, error) {
+	properties, err := ical.ReadProperties(r)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var result Result
+	var card []ical.Property
+	start := 0
+	for _, p := range properties {
+		switch {
+		case p.Name == "BEGIN" && strings.EqualFold(p.Value, "VCARD"):
+			card, start = nil, p.Line
+		case p.Name == "END" && strings.EqualFold(p.Value, "VCARD"):
+			if person, ok := parseCard(&result, source, start, card); ok {
+				result.People = append(result.People, person)
+			}
+			card = nil
+		default:
+			card = append(card, p)
+		}
+	}
+	return result, nil
+}
+
+func parseCard(result *Result, source string, line int, card []ical.Property) (timezone.Person, bool) {
+	var person timezone.Person
+	var name, tz string
+	for _, p := range card {
+		switch p.Name {
+		case "FN":
+			person.Name = unescape(p.Value)
+		case "N":
+			// Family;Given;Additional;Prefix;Suffix
+			parts := strings.Split(p.Value, ";")
+			if len(parts) > 1 {
+				name = strings.TrimSpace(unescape(parts[1]) + " " + unescape(parts[0]))
+			}
+		case "EMAIL":
+			if person.Email == "" {
+				person.Email = unescape(p.Value)
+			}
+		case "TZ":
+			tz, line = p.Value, p.Line
+		}
+	}
+	if person.Name == "" {
+		person.Name = name
+	}
+	if person.Name == "" {
+		person.Name = person.Email
+	}
+	if person.Name == "" {
+		result.problem(source, line, "card without a name")
+		return person, false
+	}
+	if tz == "" {
+		result.problem(source, line, "%s: no TZ property", person.Name)
+		return person, false
+	}
+
+	zone, err := ResolveZone(unescape(tz))
+	if err != nil {
+		// vCard 3 allows "-05:00; EST; Raleigh/North America". A named part
+		// wins, unless it contradicts a shared offset: "EST" is a fixed zone
+		// while the offset may be New York's.
+		var ambiguous *AmbiguousOffsetError
+		for _, part := range strings.Split(tz, ";") {
+			z, e := ResolveZone(unescape(part))
+			if errors.As(e, &ambiguous) {
+				err = e
+				continue
+			}
+			if e == nil && (ambiguous == nil || slices.Contains(ambiguous.Zones, z)) {
+				zone, err = z, nil
+				break
+			}
+		}
+	}
+	if err != nil {
+		result.zoneProblem(source, line, person, err)
+		return person, false
+	}
+	person.Zone = zone
+	return person, true
+}
+
+func unescape(value string) string {
+	return strings.TrimSpace(strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ", `\\`, `\`).Replace(value))
+}
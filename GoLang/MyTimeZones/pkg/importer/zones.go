@@ -0,0 +1,127 @@
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// offsetPattern matches fixed offsets such as "+02:00", "-0500", "UTC+2" or "GMT -3"
+var offsetPattern = regexp.MustCompile(`^(?i:UTC|GMT)?\s*([+-])\s*(\d{1,2})(?::?(\d{2}))?$`)
+
+// ResolveZone maps a zone as written in an import file to a canonical IANA
+// zone. It accepts IANA names in any case, deprecated aliases, city names
+// such as "New York" and UTC offsets used by a single zone, such as +05:45.
+// An offset several zones share is an *AmbiguousOffsetError listing them,
+// for the user to pick one: a fixed Etc/GMT zone would drop the DST of a
+// colleague in Berlin writing +01:00.
+func ResolveZone(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("zone is empty")
+	}
+
+	if m := offsetPattern.FindStringSubmatch(value); m != nil {
+		return offsetZone(value, m[1], m[2], m[3])
+	}
+	if strings.EqualFold(value, "UTC") || strings.EqualFold(value, "GMT") || strings.EqualFold(value, "Z") {
+		return "UTC", nil
+	}
+
+	if !strings.EqualFold(value, "Local") {
+		if _, err := time.LoadLocation(value); err == nil {
+			canonical, _ := timezone.CanonicalZone(value)
+			return canonical, nil
+		}
+	}
+
+	city := strings.ReplaceAll(value, " ", "_")
+	for _, zone := range timezone.GetTimeZones() {
+		last := zone[strings.LastIndex(zone, "/")+1:]
+		if strings.EqualFold(zone, value) || strings.EqualFold(last, city) {
+			canonical, _ := timezone.CanonicalZone(zone)
+			return canonical, nil
+		}
+	}
+	return "", fmt.Errorf("unknown zone %q", value)
+}
+
+// AmbiguousOffsetError reports a UTC offset several zones use
+type AmbiguousOffsetError struct {
+	Offset string
+	// Zones are the canonical zones using the offset, sorted
+	Zones []string
+}
+
+func (e *AmbiguousOffsetError) Error() string {
+	zones := e.Zones
+	more := ""
+	if len(zones) > 4 {
+		zones, more = zones[:4], fmt.Sprintf(" and %d more", len(e.Zones)-4)
+	}
+	return fmt.Sprintf("offset %s is used by %s%s, pick a zone", e.Offset, strings.Join(zones, ", "), more)
+}
+
+// OffsetKey normalizes a UTC offset such as "UTC+2", "+0200" or "GMT -3"
+// to the "+02:00" form, so offsets written differently compare equal. ok is
+// false when value isn't an offset.
+func OffsetKey(value string) (key string, ok bool) {
+	m := offsetPattern.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return "", false
+	}
+	h, _ := strconv.Atoi(m[2])
+	minutes, _ := strconv.Atoi(m[3])
+	return fmt.Sprintf("%s%02d:%02d", m[1], h, minutes), true
+}
+
+// offsetIndexes search zones by their offset in January and in July, so
+// an offset matches the zones using it in standard time or in DST
+var offsetIndexes = sync.OnceValue(func() []*timezone.ZoneIndex {
+	year := time.Now().Year()
+	return []*timezone.ZoneIndex{
+		timezone.NewZoneIndex(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		timezone.NewZoneIndex(time.Date(year, time.July, 1, 0, 0, 0, 0, time.UTC)),
+	}
+})
+
+// offsetZone returns the zone using a UTC offset, through the offset search
+// of ZoneIndex. Fixed Etc zones aren't offered.
+func offsetZone(value, sign, hours, minutes string) (string, error) {
+	h, _ := strconv.Atoi(hours)
+	m, _ := strconv.Atoi(minutes)
+	if (sign == "+" && h > 14) || (sign == "-" && h > 12) || m >= 60 {
+		return "", fmt.Errorf("offset %s is out of range", value)
+	}
+
+	seen := make(map[string]bool)
+	var zones []string
+	for _, index := range offsetIndexes() {
+		for _, r := range index.Search(value) {
+			if !strings.HasPrefix(r.Reason, "offset") {
+				continue
+			}
+			zone, _ := timezone.CanonicalZone(r.Zone)
+			if seen[zone] || strings.HasPrefix(zone, "Etc/") || (zone != "UTC" && !strings.Contains(zone, "/")) {
+				continue
+			}
+			seen[zone] = true
+			zones = append(zones, zone)
+		}
+	}
+	sort.Strings(zones)
+	switch len(zones) {
+	case 0:
+		return "", fmt.Errorf("no zone uses offset %s", value)
+	case 1:
+		return zones[0], nil
+	default:
+		return "", &AmbiguousOffsetError{Offset: value, Zones: zones}
+	}
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/yourusername/MyTimeZones/pkg/export"
+	"github.com/yourusername/MyTimeZones/pkg/logger"
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// DefaultAddress only accepts connections from the local machine
+const DefaultAddress = "127.0.0.1:8765"
+
+// Server is the embedded HTTP/JSON API
+type Server struct {
+	address     string
+	timeManager *timezone.Manager
+	logger      *logger.Logger
+	httpServer  *http.Server
+}
+
+func NewServer(address string, timeManager *timezone.Manager, logger *logger.Logger) *Server {
+	if address == "" {
+		address = DefaultAddress
+	}
+	s := &Server{
+		address:     address,
+		timeManager: timeManager,
+		logger:      logger,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/times", s.handleTimes)
+	mux.HandleFunc("GET /api/convert", s.handleConvert)
+	mux.HandleFunc("GET /api/zones", s.handleZones)
+	s.httpServer = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	return s
+}
+
+// Handler returns the API handler, mainly for tests
+func (s *Server) Handler() http.Handler {
+	return s.httpServer.Handler
+}
+
+// Start binds the listening socket and serves requests in the background
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.address, err)
+	}
+	s.logger.Info("API server listening on http://%s", listener.Addr())
+
+	go func() {
+		if err := s.httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("API server stopped: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Close stops the server, waiting briefly for running requests
+func (s *Server) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	return s.httpServer.Shutdown(ctx)
+}
+
+// handleTimes returns every configured zone, now or at ?at= in the Local zone
+func (s *Server) handleTimes(w http.ResponseWriter, r *http.Request) {
+	at := time.Now()
+	if value := r.URL.Query().Get("at"); value != "" {
+		var err error
+		at, err = timezone.ParseTimeIn(value, s.timeManager.GetConfig().Local.Zone)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	timeInfo, err := s.timeManager.GetTimeInfoAt(at)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, export.NewRecords(timeInfo))
+}
+
+// convertResponse is the body returned by /api/convert
+type convertResponse struct {
+	Zone  string          `json:"zone"`
+	Time  string          `json:"time"`
+	UTC   string          `json:"utc"`
+	Zones []export.Record `json:"zones"`
+}
+
+// handleConvert converts ?time= in ?zone= (Local by default) to every
+// configured zone, plus any extra zones listed in ?to=
+func (s *Server) handleConvert(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	cfg := s.timeManager.GetConfig()
+	zone := query.Get("zone")
+	if zone == "" {
+		zone = cfg.Local.Zone
+	}
+	at, err := timezone.ParseTimeIn(query.Get("time"), zone)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Use a throwaway manager so extra zones never touch the configuration
+	others := append([]timezone.TimeZoneEntry(nil), cfg.Others...)
+	for _, name := range strings.Split(query.Get("to"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			others = append(others, timezone.TimeZoneEntry{Zone: name, Description: name})
+		}
+	}
+	cfg.Others = others
+	converter, err := timezone.NewManagerFromConfig(cfg)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	converter.SetHolidays(s.timeManager.Holidays())
+
+	timeInfo, err := converter.GetTimeInfoAt(at)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	loc, _ := time.LoadLocation(zone)
+	writeJSON(w, convertResponse{
+		Zone:  zone,
+		Time:  at.In(loc).Format(time.RFC3339),
+		UTC:   at.UTC().Format(time.RFC3339),
+		Zones: export.NewRecords(timeInfo),
+	})
+}
+
+// handleZones lists every known IANA zone name
+func (s *Server) handleZones(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, timezone.GetTimeZones())
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/yourusername/MyTimeZones/pkg/holiday"
+	"github.com/yourusername/MyTimeZones/pkg/logger"
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+func TestServer_Convert(t *testing.T) {
+	manager, err := timezone.NewManagerFromConfig(timezone.TimeZoneConfig{
+		Local:  timezone.TimeZoneEntry{Zone: "Europe/Lisbon", Description: "Lisbon"},
+		Others: []timezone.TimeZoneEntry{{Zone: "Asia/Tokyo", Description: "Tokyo"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+	handler := NewServer("", manager, logger.NewLogger("error")).Handler()
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+		wantZones  int
+	}{
+		{"Configured zones", "/api/convert?time=2025-03-30+16:00&zone=Europe/Lisbon", http.StatusOK, 2},
+		{"Extra zones", "/api/convert?time=2025-03-30+16:00&to=UTC,America/Denver", http.StatusOK, 4},
+		{"Unknown zone", "/api/convert?time=2025-03-30+16:00&to=Mars/Olympus", http.StatusBadRequest, 0},
+		{"Bad time", "/api/convert?time=teatime", http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.url, nil))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var body convertResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("invalid JSON: %v", err)
+			}
+			if len(body.Zones) != tt.wantZones {
+				t.Errorf("got %d zones, want %d", len(body.Zones), tt.wantZones)
+			}
+		})
+	}
+}
+
+func TestServer_ConvertHolidays(t *testing.T) {
+	manager, err := timezone.NewManagerFromConfig(timezone.TimeZoneConfig{
+		Local: timezone.TimeZoneEntry{Zone: "Europe/Lisbon", Description: "Lisbon", Holidays: "PT"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+	calendar := holiday.NewCalendar()
+	calendar.Add("PT", time.Date(2025, 4, 25, 0, 0, 0, 0, time.UTC), "Freedom Day")
+	manager.SetHolidays(calendar)
+	handler := NewServer("", manager, logger.NewLogger("error")).Handler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/convert?time=2025-04-25+10:00&to=UTC", nil))
+	var body convertResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(body.Zones) == 0 || body.Zones[0].Holiday != "today: Freedom Day" {
+		t.Errorf("zones = %+v, want Lisbon on Freedom Day", body.Zones)
+	}
+}
+
+// TestServer_ConcurrentUpdate edits a config in place, as the UI does,
+// while requests are served; run with -race
+func TestServer_ConcurrentUpdate(t *testing.T) {
+	cfg := timezone.TimeZoneConfig{
+		Local: timezone.TimeZoneEntry{Zone: "Europe/Lisbon", Description: "Lisbon"},
+		Others: []timezone.TimeZoneEntry{
+			{Zone: "Asia/Tokyo", Description: "Tokyo", Tags: []string{"team"}},
+			{Zone: "America/New_York", Description: "New York", WorkingHours: &timezone.WorkingHours{Start: "09:00", End: "17:00"}},
+		},
+	}
+	manager, err := timezone.NewManagerFromConfig(cfg)
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+	handler := NewServer("", manager, logger.NewLogger("error")).Handler()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for n := 0; n < 200; n++ {
+			// Change the entries in place, then hand them to the manager
+			entry := &cfg.Others[0]
+			entry.Description = fmt.Sprintf("Tokyo %d", n)
+			entry.Tags[0] = fmt.Sprintf("team %d", n)
+			cfg.Others[1].WorkingHours.Start = fmt.Sprintf("%02d:00", n%9)
+			cfg.Others = append(cfg.Others[:1], cfg.Others[1:]...)
+			if err := manager.UpdateConfig(cfg); err != nil {
+				t.Errorf("UpdateConfig() error = %v", err)
+				return
+			}
+		}
+	}()
+
+	urls := []string{"/api/times", "/api/convert?time=2025-03-30+16:00", "/api/zones"}
+	for n := 0; ; n++ {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, urls[n%len(urls)], nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d: %s", rec.Code, rec.Body)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+// Package solar computes the position of the sun, sunrise, sunset and civil
+// twilight offline, with the NOAA solar calculator equations. Results are
+// within a minute or two away from the poles.
+package solar
+
+import (
+	"math"
+	"time"
+)
+
+// Zenith angles of the sun events, in degrees
+const (
+	// sunriseZenith accounts for refraction and the radius of the sun
+	sunriseZenith = 90.833
+	// civilZenith is the sun 6 degrees below the horizon
+	civilZenith = 96
+)
+
+// Phase is the part of the day at a place
+type Phase string
+
+const (
+	PhaseDay      Phase = "day"
+	PhaseTwilight Phase = "twilight"
+	PhaseNight    Phase = "night"
+)
+
+// Day is the sun events of a day at a place. An event is zero when it does
+// not happen that day, near the poles.
+type Day struct {
+	Dawn    time.Time // civil twilight starts
+	Sunrise time.Time
+	Sunset  time.Time
+	Dusk    time.Time // civil twilight ends
+	// PolarDay is set when the sun never sets, PolarNight when it never rises
+	PolarDay   bool
+	PolarNight bool
+}
+
+// Times returns the sun events of the calendar day of date, in its location,
+// at the given latitude and longitude in degrees, north and east positive.
+// Events are in the location of date.
+func Times(date time.Time, latitude, longitude float64) Day {
+	loc := date.Location()
+	// The events are those of the UTC day whose solar noon falls on the
+	// local date. Far from Greenwich, as at UTC+13 or UTC-11, that is the
+	// UTC day before or after.
+	midnight := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	noon := solarNoon(midnight, longitude).In(loc)
+	switch noonDate := time.Date(noon.Year(), noon.Month(), noon.Day(), 0, 0, 0, 0, time.UTC); {
+	case noonDate.After(midnight):
+		midnight = midnight.AddDate(0, 0, -1)
+	case noonDate.Before(midnight):
+		midnight = midnight.AddDate(0, 0, 1)
+	}
+
+	var day Day
+	var set bool
+	day.Sunrise, day.Sunset, day.PolarDay, day.PolarNight = events(midnight, latitude, longitude, sunriseZenith)
+	day.Dawn, day.Dusk, set, _ = events(midnight, latitude, longitude, civilZenith)
+	if set {
+		// Twilight all night long, as in summer at high latitudes
+		day.Dawn, day.Dusk = time.Time{}, time.Time{}
+	}
+	for _, t := range []*time.Time{&day.Dawn, &day.Sunrise, &day.Sunset, &day.Dusk} {
+		if !t.IsZero() {
+			*t = t.In(loc)
+		}
+	}
+	return day
+}
+
+// events returns the instants the sun crosses zenith on the UTC day of
+// midnight, rising and setting, or whether it stays above or below it
+func events(midnight time.Time, latitude, longitude, zenith float64) (rise, set time.Time, above, below bool) {
+	// Start from solar noon, then refine each event once at its own time
+	noon := solarNoon(midnight, longitude)
+	at := func(estimate time.Time, sign float64) (time.Time, bool) {
+		for range 2 {
+			decl := declination(estimate)
+			cosH := (math.Cos(rad(zenith)) / (math.Cos(rad(latitude)) * math.Cos(rad(decl)))) - math.Tan(rad(latitude))*math.Tan(rad(decl))
+			if cosH > 1 {
+				below = true
+				return time.Time{}, false
+			}
+			if cosH < -1 {
+				above = true
+				return time.Time{}, false
+			}
+			hourAngle := deg(math.Acos(cosH))
+			offset := 720 - 4*(longitude+sign*hourAngle) - equationOfTime(estimate)
+			estimate = midnight.Add(minutes(offset))
+		}
+		return estimate, true
+	}
+
+	rise, _ = at(noon, 1)
+	set, _ = at(noon, -1)
+	return rise, set, above && rise.IsZero(), below && rise.IsZero()
+}
+
+// solarNoon returns the instant the sun crosses the meridian at longitude on
+// the UTC day of midnight
+func solarNoon(midnight time.Time, longitude float64) time.Time {
+	return midnight.Add(minutes(720 - 4*longitude - equationOfTime(midnight.Add(12*time.Hour))))
+}
+
+// Elevation returns the elevation of the sun above the horizon at an instant,
+// in degrees, without refraction
+func Elevation(at time.Time, latitude, longitude float64) float64 {
+	utc := at.UTC()
+	minute := float64(utc.Hour()*60+utc.Minute()) + float64(utc.Second())/60
+	trueSolarTime := math.Mod(minute+equationOfTime(utc)+4*longitude, 1440)
+	hourAngle := trueSolarTime/4 - 180
+	if hourAngle < -180 {
+		hourAngle += 360
+	}
+	decl := declination(utc)
+	cosZenith := math.Sin(rad(latitude))*math.Sin(rad(decl)) + math.Cos(rad(latitude))*math.Cos(rad(decl))*math.Cos(rad(hourAngle))
+	return 90 - deg(math.Acos(math.Max(-1, math.Min(1, cosZenith))))
+}
+
+// ElevationFrom returns the elevation of the sun at a place given the
+// subsolar point, in degrees. It is Elevation without the per-call solar
+// position, for shading many places at one instant.
+func ElevationFrom(subsolarLatitude, subsolarLongitude, latitude, longitude float64) float64 {
+	cosZenith := math.Sin(rad(latitude))*math.Sin(rad(subsolarLatitude)) +
+		math.Cos(rad(latitude))*math.Cos(rad(subsolarLatitude))*math.Cos(rad(longitude-subsolarLongitude))
+	return 90 - deg(math.Acos(math.Max(-1, math.Min(1, cosZenith))))
+}
+
+// PhaseAt returns whether it is day, civil twilight or night at a place
+func PhaseAt(at time.Time, latitude, longitude float64) Phase {
+	switch elevation := Elevation(at, latitude, longitude); {
+	case elevation > 90-sunriseZenith:
+		return PhaseDay
+	case elevation > 90-civilZenith:
+		return PhaseTwilight
+	default:
+		return PhaseNight
+	}
+}
+
+// SubsolarPoint returns the latitude and longitude where the sun is at the
+// zenith at an instant
+func SubsolarPoint(at time.Time) (float64, float64) {
+	utc := at.UTC()
+	minute := float64(utc.Hour()*60+utc.Minute()) + float64(utc.Second())/60
+	longitude := -(minute + equationOfTime(utc) - 720) / 4
+	longitude = math.Mod(longitude+540, 360) - 180
+	return declination(utc), longitude
+}
+
+// julianCentury returns the Julian centuries since J2000.0
+func julianCentury(t time.Time) float64 {
+	julianDay := float64(t.Unix())/86400 + 2440587.5
+	return (julianDay - 2451545) / 36525
+}
+
+// sunPosition returns the values the NOAA equations share: the geometric
+// mean longitude and anomaly of the sun, the eccentricity of the earth's
+// orbit and the corrected obliquity of the ecliptic, all in degrees
+func sunPosition(t time.Time) (meanLong, meanAnom, ecc, obliquity, appLong float64) {
+	jc := julianCentury(t)
+	meanLong = math.Mod(280.46646+jc*(36000.76983+jc*0.0003032), 360)
+	meanAnom = 357.52911 + jc*(35999.05029-0.0001537*jc)
+	ecc = 0.016708634 - jc*(0.000042037+0.0000001267*jc)
+
+	center := math.Sin(rad(meanAnom))*(1.914602-jc*(0.004817+0.000014*jc)) +
+		math.Sin(rad(2*meanAnom))*(0.019993-0.000101*jc) +
+		math.Sin(rad(3*meanAnom))*0.000289
+	omega := 125.04 - 1934.136*jc
+	appLong = meanLong + center - 0.00569 - 0.00478*math.Sin(rad(omega))
+
+	meanObliquity := 23 + (26+(21.448-jc*(46.815+jc*(0.00059-jc*0.001813)))/60)/60
+	obliquity = meanObliquity + 0.00256*math.Cos(rad(omega))
+	return meanLong, meanAnom, ecc, obliquity, appLong
+}
+
+// declination returns the declination of the sun in degrees
+func declination(t time.Time) float64 {
+	_, _, _, obliquity, appLong := sunPosition(t)
+	return deg(math.Asin(math.Sin(rad(obliquity)) * math.Sin(rad(appLong))))
+}
+
+// equationOfTime returns the difference between apparent and mean solar
+// time in minutes
+func equationOfTime(t time.Time) float64 {
+	meanLong, meanAnom, ecc, obliquity, _ := sunPosition(t)
+	y := math.Pow(math.Tan(rad(obliquity/2)), 2)
+	return 4 * deg(y*math.Sin(2*rad(meanLong))-
+		2*ecc*math.Sin(rad(meanAnom))+
+		4*ecc*y*math.Sin(rad(meanAnom))*math.Cos(2*rad(meanLong))-
+		0.5*y*y*math.Sin(4*rad(meanLong))-
+		1.25*ecc*ecc*math.Sin(2*rad(meanAnom)))
+}
+
+func minutes(m float64) time.Duration {
+	return time.Duration(m * float64(time.Minute))
+}
+
+func rad(d float64) float64 { return d * math.Pi / 180 }
+func deg(r float64) float64 { return r * 180 / math.Pi }
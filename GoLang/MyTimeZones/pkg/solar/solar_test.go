@@ -0,0 +1,110 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTimes(t *testing.T) {
+	// Reference times from the NOAA solar calculator
+	tests := []struct {
+		name       string
+		zone       string
+		date       string
+		lat, lon   float64
+		sunrise    string
+		sunset     string
+		dawn, dusk string
+		polarDay   bool
+		polarNight bool
+	}{
+		{name: "Lisbon midsummer", zone: "Europe/Lisbon", date: "2025-06-21", lat: 38.71667, lon: -9.13333,
+			sunrise: "06:12", sunset: "21:05", dawn: "05:40", dusk: "21:36"},
+		{name: "Oslo midwinter", zone: "Europe/Oslo", date: "2025-12-21", lat: 59.91667, lon: 10.75,
+			sunrise: "09:18", sunset: "15:12", dawn: "08:20", dusk: "16:09"},
+		{name: "New York equinox, after DST", zone: "America/New_York", date: "2025-03-20", lat: 40.71417, lon: -74.00639,
+			sunrise: "06:58", sunset: "19:08", dawn: "06:31", dusk: "19:35"},
+		// East of UTC+12 solar noon falls on the previous UTC day; these
+		// two are scanned from Elevation minute by minute
+		{name: "Apia, UTC+13", zone: "Pacific/Apia", date: "2025-06-21", lat: -13.83333, lon: -171.76667,
+			sunrise: "06:50", sunset: "18:09", dawn: "06:27", dusk: "18:32"},
+		{name: "Kiritimati, UTC+14", zone: "Pacific/Kiritimati", date: "2025-06-21", lat: 1.86667, lon: -157.33333,
+			sunrise: "06:25", sunset: "18:39", dawn: "06:02", dusk: "19:01"},
+		{name: "Tromso midnight sun", zone: "Europe/Oslo", date: "2025-06-21", lat: 69.65, lon: 18.96, polarDay: true},
+		{name: "Tromso polar night", zone: "Europe/Oslo", date: "2025-12-21", lat: 69.65, lon: 18.96, polarNight: true,
+			dawn: "09:31", dusk: "13:53"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Fatalf("LoadLocation: %v", err)
+			}
+			date, _ := time.ParseInLocation("2006-01-02", tt.date, loc)
+			day := Times(date, tt.lat, tt.lon)
+
+			if day.PolarDay != tt.polarDay || day.PolarNight != tt.polarNight {
+				t.Errorf("PolarDay, PolarNight = %v, %v, want %v, %v", day.PolarDay, day.PolarNight, tt.polarDay, tt.polarNight)
+			}
+			check := func(name string, got time.Time, want string) {
+				if want == "" {
+					if !got.IsZero() {
+						t.Errorf("%s = %v, want none", name, got)
+					}
+					return
+				}
+				wantTime, _ := time.ParseInLocation("2006-01-02 15:04", tt.date+" "+want, loc)
+				if diff := got.Sub(wantTime); diff < -2*time.Minute || diff > 2*time.Minute {
+					t.Errorf("%s = %v, want %s", name, got.Format("15:04"), want)
+				}
+			}
+			check("Sunrise", day.Sunrise, tt.sunrise)
+			check("Sunset", day.Sunset, tt.sunset)
+			check("Dawn", day.Dawn, tt.dawn)
+			check("Dusk", day.Dusk, tt.dusk)
+		})
+	}
+}
+
+func TestPhaseAt(t *testing.T) {
+	oslo, _ := time.LoadLocation("Europe/Oslo")
+	tests := []struct {
+		at   time.Time
+		want Phase
+	}{
+		{time.Date(2025, 12, 21, 12, 0, 0, 0, oslo), PhaseDay},
+		{time.Date(2025, 12, 21, 15, 40, 0, 0, oslo), PhaseTwilight},
+		{time.Date(2025, 12, 21, 18, 0, 0, 0, oslo), PhaseNight},
+	}
+	for _, tt := range tests {
+		if got := PhaseAt(tt.at, 59.91667, 10.75); got != tt.want {
+			t.Errorf("PhaseAt(%v) = %s, want %s", tt.at, got, tt.want)
+		}
+	}
+}
+
+func TestSubsolarPoint(t *testing.T) {
+	// At the June solstice the sun is over the Tropic of Cancer, near the
+	// Greenwich meridian at noon UTC
+	lat, lon := SubsolarPoint(time.Date(2025, 6, 21, 12, 0, 0, 0, time.UTC))
+	if lat < 23.3 || lat > 23.5 || lon < -1 || lon > 1 {
+		t.Errorf("SubsolarPoint() = %.2f, %.2f, want about 23.44, 0", lat, lon)
+	}
+}
+
+func TestElevationFrom(t *testing.T) {
+	// Shading from the subsolar point agrees with the full calculation
+	at := time.Date(2025, 3, 14, 7, 30, 0, 0, time.UTC)
+	subLat, subLon := SubsolarPoint(at)
+	places := []struct{ lat, lon float64 }{
+		{51.5, -0.1}, {-33.9, 151.2}, {40.7, -74}, {69.6, 18.9}, {0, 0},
+	}
+	for _, p := range places {
+		want := Elevation(at, p.lat, p.lon)
+		if got := ElevationFrom(subLat, subLon, p.lat, p.lon); math.Abs(got-want) > 0.01 {
+			t.Errorf("ElevationFrom(%v, %v) = %.3f, want %.3f", p.lat, p.lon, got, want)
+		}
+	}
+}
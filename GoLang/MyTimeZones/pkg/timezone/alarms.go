@@ -0,0 +1,160 @@
+package timezone
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Alarm is a reminder at a wall clock time of an IANA zone. One-shot alarms
+// set At, recurring alarms set Time and optionally Days.
+type Alarm struct {
+	Name string `json:"name"`
+	Zone string `json:"zone"`
+	// At is the "YYYY-MM-DD HH:MM" of a one-shot alarm
+	At string `json:"at,omitempty"`
+	// Time is the "HH:MM" of a recurring alarm, on Days or every day
+	Time string   `json:"time,omitempty"`
+	Days []string `json:"days,omitempty"`
+	// LastFired is when the alarm last fired or was reported missed, or
+	// when a recurring alarm was created
+	LastFired time.Time `json:"lastFired,omitzero"`
+}
+
+func (a Alarm) clone() Alarm {
+	a.Days = slices.Clone(a.Days)
+	return a
+}
+
+// Recurring reports whether the alarm repeats
+func (a Alarm) Recurring() bool {
+	return a.At == ""
+}
+
+// Validate checks the zone and the time of an alarm
+func (a Alarm) Validate() error {
+	if _, err := time.LoadLocation(a.Zone); err != nil || strings.TrimSpace(a.Zone) == "" {
+		return fmt.Errorf("unknown IANA zone %q", a.Zone)
+	}
+	if !a.Recurring() {
+		if a.Time != "" || len(a.Days) > 0 {
+			return fmt.Errorf("a one-shot alarm has no time or days")
+		}
+		_, err := ParseTimeIn(a.At, a.Zone)
+		return err
+	}
+	if _, err := ParseClock(a.Time); err != nil {
+		return err
+	}
+	for _, d := range a.Days {
+		if _, err := ParseWeekday(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String describes when the alarm rings, such as "Mon, Fri 09:00 America/New_York"
+func (a Alarm) String() string {
+	if !a.Recurring() {
+		return a.At + " " + a.Zone
+	}
+	days := "Every day"
+	if len(a.Days) > 0 {
+		days = strings.Join(a.Days, ", ")
+	}
+	return days + " " + a.Time + " " + a.Zone
+}
+
+// Next returns the first firing strictly after after, and false when a
+// one-shot alarm is already past. Firings are wall clock times in the zone
+// of the alarm, so they track its DST changes.
+func (a Alarm) Next(after time.Time) (time.Time, bool, error) {
+	if !a.Recurring() {
+		at, err := ParseTimeIn(a.At, a.Zone)
+		return at, err == nil && at.After(after), err
+	}
+	return a.search(after, 1, func(t time.Time) bool { return t.After(after) })
+}
+
+// Last returns the latest firing at or before before, and false when there
+// is none
+func (a Alarm) Last(before time.Time) (time.Time, bool, error) {
+	if !a.Recurring() {
+		at, err := ParseTimeIn(a.At, a.Zone)
+		return at, err == nil && !at.After(before), err
+	}
+	return a.search(before, -1, func(t time.Time) bool { return !t.After(before) })
+}
+
+// search walks the days from the one of from in direction, a week at most,
+// for the first firing accepted by ok
+func (a Alarm) search(from time.Time, direction int, ok func(time.Time) bool) (time.Time, bool, error) {
+	loc, err := time.LoadLocation(a.Zone)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to load timezone %s: %w", a.Zone, err)
+	}
+	minutes, err := ParseClock(a.Time)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	hours := WorkingHours{Days: a.Days}
+
+	local := from.In(loc)
+	for offset := 0; offset <= 7; offset++ {
+		t := time.Date(local.Year(), local.Month(), local.Day()+direction*offset, minutes/60, minutes%60, 0, 0, loc)
+		if hours.worksOn(t.Weekday()) && ok(t) {
+			return t, true, nil
+		}
+	}
+	return time.Time{}, false, nil
+}
+
+// AlarmFiring is an alarm due at a given instant. Index is its position in
+// the configured alarms.
+type AlarmFiring struct {
+	Index int
+	Alarm Alarm
+	At    time.Time
+}
+
+// DueAlarms returns the alarms with a firing in (from, to] that has not
+// fired yet, each once at its latest firing
+func (m *Manager) DueAlarms(from, to time.Time) []AlarmFiring {
+	var due []AlarmFiring
+	for n, a := range m.GetConfig().Alarms {
+		last, ok, err := a.Last(to)
+		if err != nil || !ok || !last.After(from) || !last.After(a.LastFired) {
+			continue
+		}
+		due = append(due, AlarmFiring{Index: n, Alarm: a, At: last})
+	}
+	return due
+}
+
+// MissedAlarms returns the alarms that should have fired while the
+// application was not running: the firings before now that are after
+// LastFired. Recurring alarms without LastFired are never missed.
+func (m *Manager) MissedAlarms(now time.Time) []AlarmFiring {
+	var missed []AlarmFiring
+	for _, f := range m.DueAlarms(time.Time{}, now) {
+		if f.Alarm.Recurring() && f.Alarm.LastFired.IsZero() {
+			continue
+		}
+		missed = append(missed, f)
+	}
+	return missed
+}
+
+// nextAlarm returns the earliest firing of any alarm after after
+func (m *Manager) nextAlarm(after time.Time) (time.Time, bool) {
+	var next time.Time
+	for _, a := range m.GetConfig().Alarms {
+		at, ok, err := a.Next(after)
+		if err == nil && ok && (next.IsZero() || at.Before(next)) {
+			next = at
+		}
+	}
+	return next, !next.IsZero()
+}
@@ -0,0 +1,122 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAlarm_Next(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		alarm  Alarm
+		after  time.Time
+		want   time.Time
+		wantOK bool
+	}{
+		{
+			name:   "Monday 09:00 from a Friday",
+			alarm:  Alarm{Zone: "America/New_York", Time: "09:00", Days: []string{"Mon"}},
+			after:  time.Date(2025, 3, 7, 12, 0, 0, 0, newYork),
+			want:   time.Date(2025, 3, 10, 9, 0, 0, 0, newYork),
+			wantOK: true,
+		},
+		{
+			// Clocks go forward on 2025-03-09, 09:00 is 13:00 UTC instead of 14:00
+			name:   "Daily alarm across DST",
+			alarm:  Alarm{Zone: "America/New_York", Time: "09:00"},
+			after:  time.Date(2025, 3, 8, 10, 0, 0, 0, newYork),
+			want:   time.Date(2025, 3, 9, 13, 0, 0, 0, time.UTC),
+			wantOK: true,
+		},
+		{
+			name:   "One-shot ahead",
+			alarm:  Alarm{Zone: "Asia/Tokyo", At: "2025-03-10 09:00"},
+			after:  time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC),
+			want:   time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+			wantOK: true,
+		},
+		{
+			name:   "One-shot past",
+			alarm:  Alarm{Zone: "Asia/Tokyo", At: "2025-03-10 09:00"},
+			after:  time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC),
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := tt.alarm.Next(tt.after)
+			if err != nil {
+				t.Fatalf("Next() error = %v", err)
+			}
+			if ok != tt.wantOK {
+				t.Fatalf("Next() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlarm_Validate(t *testing.T) {
+	tests := []struct {
+		alarm   Alarm
+		wantErr bool
+	}{
+		{Alarm{Zone: "Europe/Lisbon", At: "2025-03-10 09:00"}, false},
+		{Alarm{Zone: "Europe/Lisbon", Time: "09:00", Days: []string{"Mon", "Thu"}}, false},
+		{Alarm{Zone: "Europe/Lisbon", Time: "9am"}, true},
+		{Alarm{Zone: "Europe/Lisbon", Time: "09:00", Days: []string{"Someday"}}, true},
+		{Alarm{Zone: "Europe/Lisbon", At: "tomorrow"}, true},
+		{Alarm{Zone: "Europe/Lisbon", At: "2025-03-10 09:00", Time: "09:00"}, true},
+		{Alarm{Zone: "Nowhere/Town", Time: "09:00"}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.alarm.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%+v) error = %v, wantErr %v", tt.alarm, err, tt.wantErr)
+		}
+	}
+}
+
+func TestManager_Alarms(t *testing.T) {
+	created := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	manager, err := NewManagerFromConfig(TimeZoneConfig{
+		Local: TimeZoneEntry{Zone: "Europe/London", Description: "London"},
+		Alarms: []Alarm{
+			{Name: "Standup", Zone: "America/New_York", Time: "09:00", Days: []string{"Mon"}, LastFired: created},
+			{Name: "Call Tokyo", Zone: "Asia/Tokyo", At: "2025-03-05 09:00"},
+			{Name: "Fired", Zone: "Asia/Tokyo", At: "2025-03-04 09:00", LastFired: created.AddDate(0, 0, 3)},
+			{Name: "New", Zone: "Europe/London", Time: "08:00"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+
+	// Restarting on Tuesday 2025-03-11 reports the Monday standup and the
+	// call, but not the new alarm nor the one already fired
+	missed := manager.MissedAlarms(time.Date(2025, 3, 11, 12, 0, 0, 0, time.UTC))
+	var names []string
+	for _, f := range missed {
+		names = append(names, f.Alarm.Name)
+	}
+	if len(names) != 2 || names[0] != "Standup" || names[1] != "Call Tokyo" {
+		t.Fatalf("MissedAlarms() = %q, want Standup and Call Tokyo", names)
+	}
+	if want := time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC); !missed[0].At.Equal(want) {
+		t.Errorf("missed standup at %v, want %v", missed[0].At, want)
+	}
+
+	// While running, only firings inside the window are due
+	from := time.Date(2025, 3, 10, 7, 59, 0, 0, time.UTC)
+	due := manager.DueAlarms(from, from.Add(2*time.Minute))
+	if len(due) != 1 || due[0].Alarm.Name != "New" || due[0].Index != 3 {
+		t.Errorf("DueAlarms() = %+v, want the new alarm", due)
+	}
+}
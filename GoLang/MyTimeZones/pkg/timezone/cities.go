@@ -0,0 +1,21 @@
+package timezone
+
+// cityAliases maps cities without a zone of their own, and former names,
+// to the zone they keep the time of
+var cityAliases = map[string]string{
+	"Abu Dhabi": "Asia/Dubai", "Atlanta": "America/New_York", "Austin": "America/Chicago",
+	"Bangalore": "Asia/Kolkata", "Barcelona": "Europe/Madrid", "Beijing": "Asia/Shanghai",
+	"Bengaluru": "Asia/Kolkata", "Bombay": "Asia/Kolkata", "Boston": "America/New_York",
+	"Calcutta": "Asia/Kolkata", "Canberra": "Australia/Sydney", "Cape Town": "Africa/Johannesburg",
+	"Chennai": "Asia/Kolkata", "Dallas": "America/Chicago", "Delhi": "Asia/Kolkata",
+	"Edinburgh": "Europe/London", "Frankfurt": "Europe/Berlin", "Geneva": "Europe/Zurich",
+	"Hamburg": "Europe/Berlin", "Houston": "America/Chicago", "Hyderabad": "Asia/Kolkata",
+	"Madras": "Asia/Kolkata", "Manchester": "Europe/London",
+	"Miami": "America/New_York", "Milan": "Europe/Rome", "Montreal": "America/Toronto",
+	"Mumbai": "Asia/Kolkata", "Munich": "Europe/Berlin", "New Delhi": "Asia/Kolkata",
+	"Osaka": "Asia/Tokyo", "Ottawa": "America/Toronto", "Peking": "Asia/Shanghai",
+	"Porto": "Europe/Lisbon", "Pune": "Asia/Kolkata", "Rio de Janeiro": "America/Sao_Paulo",
+	"Saigon": "Asia/Ho_Chi_Minh", "San Diego": "America/Los_Angeles", "San Francisco": "America/Los_Angeles",
+	"San Jose": "America/Los_Angeles", "Seattle": "America/Los_Angeles", "Shenzhen": "Asia/Shanghai",
+	"Tel Aviv": "Asia/Jerusalem", "Washington": "America/New_York",
+}
@@ -0,0 +1,60 @@
+package timezone
+
+import (
+	"time"
+)
+
+// Holidays looks up public holidays by holiday set, such as "PT"
+type Holidays interface {
+	// Holiday returns the name of the holiday on the calendar day of day,
+	// in day's location
+	Holiday(set string, day time.Time) (string, bool)
+	// Sets returns the known holiday set names
+	Sets() []string
+}
+
+// HolidayFunc reports whether the calendar day of day, in day's location,
+// is a holiday. A nil HolidayFunc knows no holidays.
+type HolidayFunc func(day time.Time) bool
+
+// holidayFunc returns the holidays of set, or nil when there are none
+func holidayFunc(holidays Holidays, set string) HolidayFunc {
+	if holidays == nil || set == "" {
+		return nil
+	}
+	return func(day time.Time) bool {
+		_, ok := holidays.Holiday(set, day)
+		return ok
+	}
+}
+
+// SetHolidays sets the holiday calendars entries refer to by name
+func (m *Manager) SetHolidays(holidays Holidays) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.holidays = holidays
+}
+
+// Holidays returns the holiday calendars, nil when none were set
+func (m *Manager) Holidays() Holidays {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.holidays
+}
+
+// holidayNote describes a holiday at at or on the next day in loc, such
+// as "today: Christmas Day"
+func holidayNote(holidays Holidays, set string, at time.Time, loc *time.Location) string {
+	if holidays == nil || set == "" {
+		return ""
+	}
+	local := at.In(loc)
+	if name, ok := holidays.Holiday(set, local); ok {
+		return "today: " + name
+	}
+	tomorrow := time.Date(local.Year(), local.Month(), local.Day()+1, 12, 0, 0, 0, loc)
+	if name, ok := holidays.Holiday(set, tomorrow); ok {
+		return "tomorrow: " + name
+	}
+	return ""
+}
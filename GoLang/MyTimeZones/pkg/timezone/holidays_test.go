@@ -0,0 +1,82 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeHolidays has one holiday per set, on a fixed date
+type fakeHolidays map[string]string
+
+func (f fakeHolidays) Holiday(set string, day time.Time) (string, bool) {
+	if date, ok := f[set]; ok && day.Format("2006-01-02") == date {
+		return set + " holiday", true
+	}
+	return "", false
+}
+
+func (f fakeHolidays) Sets() []string {
+	return nil
+}
+
+func TestHolidays(t *testing.T) {
+	hours := &WorkingHours{Start: "09:00", End: "17:00"}
+	manager, err := NewManagerFromConfig(TimeZoneConfig{
+		Local: TimeZoneEntry{Zone: "Europe/Lisbon", Description: "Lisbon", WorkingHours: hours, Holidays: "PT"},
+		Others: []TimeZoneEntry{
+			{Zone: "Asia/Tokyo", Description: "Tokyo", WorkingHours: hours, Holidays: "JP"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+	manager.SetHolidays(fakeHolidays{"PT": "2025-04-25", "JP": "2025-04-29"})
+
+	// Thursday 2025-04-24 02:00 in Lisbon is 10:00 in Tokyo
+	at, _ := ParseTimeIn("2025-04-24 02:00", "Europe/Lisbon")
+	timeInfo, err := manager.GetTimeInfoAt(at)
+	if err != nil {
+		t.Fatalf("GetTimeInfoAt() error = %v", err)
+	}
+	if got := timeInfo[0].Holiday; got != "tomorrow: PT holiday" {
+		t.Errorf("Lisbon holiday = %q, want tomorrow", got)
+	}
+	if got := timeInfo[1].Status; got != "working" {
+		t.Errorf("Tokyo status = %q, want working", got)
+	}
+
+	// Friday 2025-04-25 10:00 in Lisbon is a holiday
+	at, _ = ParseTimeIn("2025-04-25 10:00", "Europe/Lisbon")
+	timeInfo, _ = manager.GetTimeInfoAt(at)
+	if got := timeInfo[0].Status; got != "holiday" {
+		t.Errorf("Lisbon status = %q, want holiday", got)
+	}
+	if got := timeInfo[0].Holiday; got != "today: PT holiday" {
+		t.Errorf("Lisbon holiday = %q, want today", got)
+	}
+
+}
+
+func TestPlanMeeting_Holidays(t *testing.T) {
+	cfg := TimeZoneConfig{
+		Local:  TimeZoneEntry{Zone: "Europe/Lisbon", Description: "Lisbon"},
+		Others: []TimeZoneEntry{{Zone: "Europe/Madrid", Description: "Madrid", Holidays: "ES"}},
+	}
+	plan := func(holidays Holidays) int {
+		slots, err := PlanMeeting(cfg, MeetingRequest{
+			Date:     time.Date(2025, 4, 28, 0, 0, 0, 0, time.UTC),
+			Holidays: holidays,
+		})
+		if err != nil {
+			t.Fatalf("PlanMeeting() error = %v", err)
+		}
+		return slots[0].Score
+	}
+
+	if got := plan(nil); got != 2 {
+		t.Errorf("best score without holidays = %d, want 2", got)
+	}
+	if got := plan(fakeHolidays{"ES": "2025-04-28"}); got != 1 {
+		t.Errorf("best score on a Madrid holiday = %d, want 1", got)
+	}
+}
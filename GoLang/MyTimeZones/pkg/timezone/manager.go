@@ -5,27 +5,147 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 )
 
 type TimeInfo struct {
-	Name        string
-	Description string
-	Date        string
-	Time        string
-	Diff        string
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Date        string   `json:"date"`
+	Time        string   `json:"time"`
+	Diff        string   `json:"diff"`
+	Status      string   `json:"status,omitempty"`
+	Holiday     string   `json:"holiday,omitempty"`
+	Group       string   `json:"group,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	// Daylight is "day", "twilight" or "night", Sun the next sunrise or
+	// sunset such as "sunset in 1h20m"; both are empty without coordinates
+	Daylight string `json:"daylight,omitempty"`
+	Sun      string `json:"sun,omitempty"`
+	// Instant is the reference instant in the zone's location
+	Instant time.Time `json:"instant"`
+	// UTCOffset and DiffSeconds are the offsets to UTC and Local in seconds
+	UTCOffset   int `json:"utcOffset"`
+	DiffSeconds int `json:"diffSeconds"`
+	// Transition is the next change of the zone's UTC offset, if any
+	Transition *Transition `json:"transition,omitempty"`
+	// DiffChange is the next change of the offset relative to Local, if any
+	DiffChange *Transition `json:"diffChange,omitempty"`
 }
 
 // TimeZoneConfig represents the configuration structure for timezones
 type TimeZoneConfig struct {
 	Local  TimeZoneEntry   `json:"local"`
 	Others []TimeZoneEntry `json:"others"`
+	People []Person        `json:"people,omitempty"`
+	// Notifications are the desktop notifications the user subscribed to
+	Notifications []NotificationRule `json:"notifications,omitempty"`
+	// Alarms are reminders at a time of a given zone
+	Alarms []Alarm `json:"alarms,omitempty"`
 }
 
 // TimeZoneEntry represents a single timezone entry
 type TimeZoneEntry struct {
-	Zone        string `json:"zone"`
-	Description string `json:"description"`
+	Zone         string        `json:"zone"`
+	Description  string        `json:"description"`
+	WorkingHours *WorkingHours `json:"workingHours,omitempty"`
+	Group        string        `json:"group,omitempty"`
+	Tags         []string      `json:"tags,omitempty"`
+	// Holidays names the public holiday set of the zone, such as "PT"
+	Holidays string `json:"holidays,omitempty"`
+	// Coordinates place the entry for sunrise and sunset, by default the
+	// principal city of the zone
+	Coordinates *Coordinates `json:"coordinates,omitempty"`
+}
+
+// Clone returns a deep copy of the configuration, sharing no slices or
+// pointers with c
+func (c TimeZoneConfig) Clone() TimeZoneConfig {
+	c.Local = c.Local.clone()
+	c.Others = cloneEach(c.Others, TimeZoneEntry.clone)
+	c.People = cloneEach(c.People, Person.clone)
+	c.Notifications = slices.Clone(c.Notifications)
+	c.Alarms = cloneEach(c.Alarms, Alarm.clone)
+	return c
+}
+
+// cloneEach copies s, cloning every element
+func cloneEach[T any](s []T, clone func(T) T) []T {
+	if s == nil {
+		return nil
+	}
+	out := make([]T, len(s))
+	for n, v := range s {
+		out[n] = clone(v)
+	}
+	return out
+}
+
+func (e TimeZoneEntry) clone() TimeZoneEntry {
+	e.WorkingHours = e.WorkingHours.clone()
+	e.Tags = slices.Clone(e.Tags)
+	if e.Coordinates != nil {
+		place := *e.Coordinates
+		e.Coordinates = &place
+	}
+	return e
+}
+
+// HasTag reports whether the entry carries tag, ignoring case
+func (e TimeZoneEntry) HasTag(tag string) bool {
+	for _, t := range e.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Groups returns the distinct groups of the configured entries, sorted
+func (c TimeZoneConfig) Groups() []string {
+	return c.distinct(func(e TimeZoneEntry) []string { return []string{e.Group} })
+}
+
+// Tags returns the distinct tags of the configured entries, sorted
+func (c TimeZoneConfig) Tags() []string {
+	return c.distinct(func(e TimeZoneEntry) []string { return e.Tags })
+}
+
+func (c TimeZoneConfig) distinct(values func(TimeZoneEntry) []string) []string {
+	seen := make(map[string]bool)
+	var result []string
+	for _, e := range append([]TimeZoneEntry{c.Local}, c.Others...) {
+		for _, v := range values(e) {
+			key := strings.ToLower(strings.TrimSpace(v))
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			result = append(result, strings.TrimSpace(v))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return strings.ToLower(result[i]) < strings.ToLower(result[j])
+	})
+	return result
+}
+
+// Status returns the working hours status of the entry at the given instant,
+// or an empty string when no working hours are configured
+func (e TimeZoneEntry) Status(at time.Time, loc *time.Location) string {
+	return e.statusExcept(at, loc, nil)
+}
+
+// statusExcept is Status treating the holidays of the entry as non-working
+func (e TimeZoneEntry) statusExcept(at time.Time, loc *time.Location, holidays Holidays) string {
+	if e.WorkingHours == nil {
+		return ""
+	}
+	return e.WorkingHours.StatusExcept(at, loc, holidayFunc(holidays, e.Holidays))
 }
 
 var DefaultTimeZoneConfig = TimeZoneConfig{
@@ -47,12 +167,22 @@ var DefaultTimeZoneConfig = TimeZoneConfig{
 }
 
 type Manager struct {
+	mu         sync.RWMutex
 	config     TimeZoneConfig
 	configFile string
 	ctx        context.Context
 	cancel     context.CancelFunc
+
+	// holidays are the calendars entries refer to, see SetHolidays
+	holidays Holidays
+
+	// locations caches loaded zones, see location
+	locMu     sync.Mutex
+	locations map[string]*time.Location
 }
 
+// NewManager loads the time zone section of a config file. A file that
+// fails validation is a *ValidationError.
 func NewManager(configFile string) (*Manager, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	tzm := &Manager{
@@ -62,25 +192,20 @@ func NewManager(configFile string) (*Manager, error) {
 	}
 
 	// Always load the time zone section from the file
-	_ = tzm.loadConfig()
+	if err := tzm.loadConfig(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to load %s: %w", configFile, err)
+	}
+	if err := tzm.validateConfig(); err != nil {
+		cancel()
+		return nil, err
+	}
 
 	return tzm, nil
 }
 
 func (m *Manager) validateConfig() error {
-	// Validate local timezone
-	if _, err := time.LoadLocation(m.config.Local.Zone); err != nil {
-		return fmt.Errorf("invalid local timezone %s: %w", m.config.Local.Zone, err)
-	}
-
-	// Validate other timezones
-	for _, tz := range m.config.Others {
-		if _, err := time.LoadLocation(tz.Zone); err != nil {
-			return fmt.Errorf("invalid timezone %s: %w", tz.Zone, err)
-		}
-	}
-
-	return nil
+	return m.config.Validate().Err()
 }
 
 func (m *Manager) loadConfig() error {
@@ -109,34 +234,52 @@ func (m *Manager) createDefaultConfig() error {
 }
 
 func (m *Manager) GetTimeInfo() ([]TimeInfo, error) {
-	localLoc, err := time.LoadLocation(m.config.Local.Zone)
+	return m.GetTimeInfoAt(time.Now())
+}
+
+// GetTimeInfoAt returns the time info of every configured zone at the given instant
+func (m *Manager) GetTimeInfoAt(at time.Time) ([]TimeInfo, error) {
+	cfg := m.GetConfig()
+	holidays := m.Holidays()
+	localLoc, err := m.location(cfg.Local.Zone)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load local timezone: %w", err)
 	}
 
-	localTime := time.Now().In(localLoc)
-	timeInfo := make([]TimeInfo, 0, len(m.config.Others)+1)
+	localTime := at.In(localLoc)
+	_, localOffset := localTime.Zone()
+	timeInfo := make([]TimeInfo, 0, len(cfg.Others)+1)
 
 	// Add local timezone info
+	localDaylight, localSun := cfg.Local.sun(at, localLoc)
 	timeInfo = append(timeInfo, TimeInfo{
-		Name:        m.config.Local.Zone,
-		Description: m.config.Local.Description,
+		Name:        cfg.Local.Zone,
+		Description: cfg.Local.Description,
 		Date:        localTime.Format("2006-01-02"),
 		Time:        localTime.Format("15:04:05"),
 		Diff:        "00:00",
+		Status:      cfg.Local.statusExcept(at, localLoc, holidays),
+		Holiday:     holidayNote(holidays, cfg.Local.Holidays, at, localLoc),
+		Daylight:    localDaylight,
+		Sun:         localSun,
+		Group:       cfg.Local.Group,
+		Tags:        cfg.Local.Tags,
+		Instant:     localTime,
+		UTCOffset:   localOffset,
+		Transition:  NextTransition(localLoc, at),
 	})
 
 	// Add other timezone info
-	for _, tz := range m.config.Others {
-		loc, err := time.LoadLocation(tz.Zone)
+	for _, tz := range cfg.Others {
+		loc, err := m.location(tz.Zone)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load timezone %s: %w", tz.Zone, err)
 		}
 
-		currentTime := time.Now().In(loc)
-		_, localOffset := localTime.Zone()
+		currentTime := at.In(loc)
 		_, otherOffset := currentTime.Zone()
 		offsetDiff := otherOffset - localOffset
+		daylight, sun := tz.sun(at, loc)
 
 		timeInfo = append(timeInfo, TimeInfo{
 			Name:        tz.Zone,
@@ -144,12 +287,43 @@ func (m *Manager) GetTimeInfo() ([]TimeInfo, error) {
 			Date:        currentTime.Format("2006-01-02"),
 			Time:        currentTime.Format("15:04:05"),
 			Diff:        formatOffset(offsetDiff),
+			Status:      tz.statusExcept(at, loc, holidays),
+			Holiday:     holidayNote(holidays, tz.Holidays, at, loc),
+			Daylight:    daylight,
+			Sun:         sun,
+			Group:       tz.Group,
+			Tags:        tz.Tags,
+			Instant:     currentTime,
+			UTCOffset:   otherOffset,
+			DiffSeconds: offsetDiff,
+			Transition:  NextTransition(loc, at),
+			DiffChange:  NextRelativeTransition(localLoc, loc, at),
 		})
 	}
 
 	return timeInfo, nil
 }
 
+// ParseTimeIn parses a wall clock value such as "2025-03-30 16:00" in the
+// given zone and returns the matching instant
+func ParseTimeIn(value, zone string) (time.Time, error) {
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to load timezone %s: %w", zone, err)
+	}
+
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q, expected YYYY-MM-DD HH:MM", value)
+}
+
 func formatOffset(offsetSeconds int) string {
 	sign := "+"
 	if offsetSeconds < 0 {
@@ -169,14 +343,20 @@ func (m *Manager) Close() {
 	}
 }
 
-// GetConfig returns a copy of the current configuration
+// GetConfig returns a copy of the current configuration, which the caller
+// may change freely
 func (m *Manager) GetConfig() TimeZoneConfig {
-	return m.config
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.config.Clone()
 }
 
-// UpdateConfig updates the timezone configuration and validates it
+// UpdateConfig updates the timezone configuration and validates it. The
+// manager keeps a copy, so the caller may go on changing config.
 func (m *Manager) UpdateConfig(config TimeZoneConfig) error {
-	m.config = config
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.config = config.Clone()
 	if err := m.validateConfig(); err != nil {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
@@ -184,14 +364,22 @@ func (m *Manager) UpdateConfig(config TimeZoneConfig) error {
 }
 
 func NewManagerFromConfig(cfg TimeZoneConfig) (*Manager, error) {
-	ctx, cancel := context.WithCancel(context.Background())
-	tzm := &Manager{
-		config: cfg,
-		ctx:    ctx,
-		cancel: cancel,
-	}
+	tzm := NewUncheckedManager(cfg)
 	if err := tzm.validateConfig(); err != nil {
+		tzm.Close()
 		return nil, err
 	}
 	return tzm, nil
 }
+
+// NewUncheckedManager creates a manager for cfg without validating it, for
+// a caller that reports the problems itself and keeps running, as the GUI
+// does while the config is being fixed
+func NewUncheckedManager(cfg TimeZoneConfig) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		config: cfg.Clone(),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
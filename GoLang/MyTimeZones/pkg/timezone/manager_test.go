@@ -1,6 +1,7 @@
 package timezone
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -24,10 +25,13 @@ func TestNewManager(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := NewManager(tt.configFile)
+			manager, err := NewManager(tt.configFile)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewManager() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if err != nil && manager != nil {
+				t.Error("NewManager() returned a manager with its error")
+			}
 		})
 	}
 }
@@ -52,3 +56,68 @@ func TestManager_GetTimeInfo(t *testing.T) {
 		t.Errorf("Local timezone diff should be 00:00, got %s", timeInfo[0].Diff)
 	}
 }
+
+func TestNewUncheckedManager(t *testing.T) {
+	cfg := TimeZoneConfig{
+		Local:  TimeZoneEntry{Zone: "Europe/Lisbon", Description: "Lisbon"},
+		Others: []TimeZoneEntry{{Zone: "Mars/Olympus_Mons", Description: "Mars Base"}},
+	}
+	if manager, err := NewManagerFromConfig(cfg); err == nil || manager != nil {
+		t.Errorf("NewManagerFromConfig() = %v, %v, want no manager and an error", manager, err)
+	}
+
+	manager := NewUncheckedManager(cfg)
+	defer manager.Close()
+	if got := manager.GetConfig(); !reflect.DeepEqual(got, cfg) {
+		t.Errorf("GetConfig() = %+v, want the invalid config %+v", got, cfg)
+	}
+}
+
+func TestManager_GetTimeInfoAt(t *testing.T) {
+	manager, err := NewManagerFromConfig(TimeZoneConfig{
+		Local:  TimeZoneEntry{Zone: "Europe/Lisbon", Description: "Lisbon"},
+		Others: []TimeZoneEntry{{Zone: "Asia/Tokyo", Description: "Tokyo"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+
+	at, err := ParseTimeIn("2025-03-25 16:00", "Europe/Lisbon")
+	if err != nil {
+		t.Fatalf("ParseTimeIn() error = %v", err)
+	}
+
+	timeInfo, err := manager.GetTimeInfoAt(at)
+	if err != nil {
+		t.Fatalf("GetTimeInfoAt() error = %v", err)
+	}
+
+	tokyo := timeInfo[1]
+	if tokyo.Date != "2025-03-26" || tokyo.Time != "01:00:00" {
+		t.Errorf("Tokyo time = %s %s, want 2025-03-26 01:00:00", tokyo.Date, tokyo.Time)
+	}
+	if tokyo.Diff != "+09:00" {
+		t.Errorf("Tokyo diff = %s, want +09:00", tokyo.Diff)
+	}
+}
+
+func TestTimeZoneConfig_GroupsAndTags(t *testing.T) {
+	cfg := TimeZoneConfig{
+		Local: TimeZoneEntry{Zone: "Europe/Lisbon", Group: "Office", Tags: []string{"oncall"}},
+		Others: []TimeZoneEntry{
+			{Zone: "Asia/Tokyo", Group: "customers", Tags: []string{"APAC", "OnCall"}},
+			{Zone: "America/New_York", Group: "office"},
+			{Zone: "Europe/Paris"},
+		},
+	}
+
+	if got, want := cfg.Groups(), []string{"customers", "Office"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Groups() = %v, want %v", got, want)
+	}
+	if got, want := cfg.Tags(), []string{"APAC", "oncall"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Tags() = %v, want %v", got, want)
+	}
+	if !cfg.Others[0].HasTag("apac") || cfg.Others[1].HasTag("apac") {
+		t.Error("HasTag() should match tags ignoring case")
+	}
+}
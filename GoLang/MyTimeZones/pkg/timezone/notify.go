@@ -0,0 +1,282 @@
+package timezone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Notification rule kinds
+const (
+	RuleWorkStart    = "workStart"    // the working day of the zone starts
+	RuleWorkEnd      = "workEnd"      // the working day of the zone ends
+	RuleTimeOfDay    = "timeOfDay"    // the zone reaches Time
+	RuleWeekendStart = "weekendStart" // the first non-working day after a working day begins
+)
+
+// RuleKinds lists every notification rule kind
+var RuleKinds = []string{RuleWorkStart, RuleWorkEnd, RuleTimeOfDay, RuleWeekendStart}
+
+// NotificationRule subscribes to a moment in the day of a zone, such as
+// "Tokyo team's day starts" or "New York is at 17:00". Working hours and
+// holidays are those of the configured entry for the zone, or
+// DefaultWorkingHours.
+type NotificationRule struct {
+	Zone string `json:"zone"`
+	Kind string `json:"kind"`
+	// Time is the "HH:MM" wall clock time of RuleTimeOfDay rules
+	Time string `json:"time,omitempty"`
+	// Label names the zone in the notification, by default its description
+	Label    string `json:"label,omitempty"`
+	Disabled bool   `json:"disabled,omitempty"`
+}
+
+// Validate checks the zone, the kind and the time of a rule
+func (r NotificationRule) Validate() error {
+	if _, err := time.LoadLocation(r.Zone); err != nil || strings.TrimSpace(r.Zone) == "" {
+		return fmt.Errorf("unknown IANA zone %q", r.Zone)
+	}
+	switch r.Kind {
+	case RuleWorkStart, RuleWorkEnd, RuleWeekendStart:
+	case RuleTimeOfDay:
+		if _, err := ParseClock(r.Time); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown rule kind %q, expected one of %s", r.Kind, strings.Join(RuleKinds, ", "))
+	}
+	return nil
+}
+
+// Text describes the moment, using label for the zone
+func (r NotificationRule) Text(label string) string {
+	if r.Label != "" {
+		label = r.Label
+	}
+	switch r.Kind {
+	case RuleWorkStart:
+		return label + "'s day starts"
+	case RuleWorkEnd:
+		return label + "'s day ends"
+	case RuleTimeOfDay:
+		return label + " is at " + r.Time
+	case RuleWeekendStart:
+		return label + " enters the weekend"
+	}
+	return label
+}
+
+// maxRuleDays bounds the search for the next firing, long enough for any
+// run of holidays
+const maxRuleDays = 366
+
+// Next returns the first instant strictly after after at which the rule
+// fires, given the working hours and holidays of the zone. Times are wall
+// clock times in loc, so a 17:00 rule stays at 17:00 across DST changes; a
+// time skipped by a DST change fires when the clock jumps past it.
+func (r NotificationRule) Next(after time.Time, loc *time.Location, hours WorkingHours, holiday HolidayFunc) (time.Time, error) {
+	if err := r.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	clock := func(s string) int {
+		minutes, _ := ParseClock(s)
+		return minutes
+	}
+	working := func(day time.Time) bool {
+		return hours.worksOn(day.Weekday()) && (holiday == nil || !holiday(day))
+	}
+
+	local := after.In(loc)
+	for offset := 0; offset <= maxRuleDays; offset++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, loc)
+		at := func(minutes, days int) time.Time {
+			return time.Date(day.Year(), day.Month(), day.Day()+days, minutes/60, minutes%60, 0, 0, loc)
+		}
+
+		var candidate time.Time
+		switch r.Kind {
+		case RuleWorkStart:
+			if working(day) {
+				candidate = at(clock(hours.Start), 0)
+			}
+		case RuleWorkEnd:
+			if working(day) {
+				start, end := clock(hours.Start), clock(hours.End)
+				if end <= start {
+					candidate = at(end, 1) // an overnight shift ends the next day
+				} else {
+					candidate = at(end, 0)
+				}
+			}
+		case RuleTimeOfDay:
+			candidate = at(clock(r.Time), 0)
+		case RuleWeekendStart:
+			if !working(day) && working(day.AddDate(0, 0, -1)) {
+				candidate = day
+			}
+		}
+		if !candidate.IsZero() && candidate.After(after) {
+			return candidate, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%s never fires in %s", r.Kind, r.Zone)
+}
+
+// Notification is a rule firing at a given instant
+type Notification struct {
+	Rule NotificationRule
+	At   time.Time
+	Text string
+}
+
+// NextNotifications returns, for every enabled rule, its next firing after
+// after, sorted by instant. A rule that fails is skipped and reported in the
+// error, the notifications of the other rules are still returned.
+func (m *Manager) NextNotifications(after time.Time) ([]Notification, error) {
+	cfg := m.GetConfig()
+	holidays := m.Holidays()
+
+	notifications := make([]Notification, 0, len(cfg.Notifications))
+	var errs []error
+	for n, r := range cfg.Notifications {
+		if r.Disabled {
+			continue
+		}
+		loc, err := m.location(r.Zone)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("notification %d: failed to load timezone %s: %w", n, r.Zone, err))
+			continue
+		}
+		entry := cfg.EntryFor(r.Zone)
+		at, err := r.Next(after, loc, cfg.HoursFor(r.Zone), holidayFunc(holidays, entry.Holidays))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("notification %d: %w", n, err))
+			continue
+		}
+		notifications = append(notifications, Notification{Rule: r, At: at, Text: r.Text(entry.Description)})
+	}
+	sort.SliceStable(notifications, func(i, j int) bool {
+		return notifications[i].At.Before(notifications[j].At)
+	})
+	return notifications, errors.Join(errs...)
+}
+
+// EntryFor returns the configured entry of a zone, or an entry described by
+// the zone name when there is none
+func (c TimeZoneConfig) EntryFor(zone string) TimeZoneEntry {
+	for _, e := range append([]TimeZoneEntry{c.Local}, c.Others...) {
+		if e.Zone == zone {
+			return e
+		}
+	}
+	return TimeZoneEntry{Zone: zone, Description: zone}
+}
+
+// HoursFor returns the working hours of the configured entry of a zone, or
+// DefaultWorkingHours
+func (c TimeZoneConfig) HoursFor(zone string) WorkingHours {
+	if entry := c.EntryFor(zone); entry.WorkingHours != nil {
+		return *entry.WorkingHours
+	}
+	return DefaultWorkingHours
+}
+
+// schedulerCheckInterval bounds how long the scheduler sleeps, so config
+// changes, clock changes and suspends are picked up
+const schedulerCheckInterval = time.Minute
+
+// Scheduler calls fire for every notification rule as it comes due, and
+// the alarm handler, see OnAlarm, for every alarm
+type Scheduler struct {
+	manager *Manager
+	fire    func(Notification)
+	alarm   func(AlarmFiring)
+	onError func(error)
+	now     func() time.Time
+
+	// lastErr is the last error reported, so a broken rule is reported when
+	// it breaks rather than on every wake up
+	lastErr string
+}
+
+// NewScheduler creates a scheduler for the rules of the manager's config
+func NewScheduler(m *Manager, fire func(Notification)) *Scheduler {
+	return &Scheduler{manager: m, fire: fire, now: time.Now}
+}
+
+// OnAlarm sets the function called for every alarm as it comes due
+func (s *Scheduler) OnAlarm(fire func(AlarmFiring)) {
+	s.alarm = fire
+}
+
+// OnError sets the function called with the error of the rules that can't
+// fire. The other rules keep firing.
+func (s *Scheduler) OnError(report func(error)) {
+	s.onError = report
+}
+
+// next returns the next notifications after after, reporting the rules that
+// fail
+func (s *Scheduler) next(after time.Time) []Notification {
+	next, err := s.manager.NextNotifications(after)
+	switch {
+	case err == nil:
+		s.lastErr = ""
+	case err.Error() != s.lastErr:
+		s.lastErr = err.Error()
+		if s.onError != nil {
+			s.onError(err)
+		}
+	}
+	return next
+}
+
+// Run fires notifications until ctx is done. Rules are re-read on every
+// wake up, so edits apply without a restart.
+func (s *Scheduler) Run(ctx context.Context) {
+	last := s.now()
+	for {
+		wait := schedulerCheckInterval
+		if next := s.next(last); len(next) > 0 {
+			if d := next[0].At.Sub(s.now()); d < wait {
+				wait = max(d, 0)
+			}
+		}
+		if next, ok := s.manager.nextAlarm(last); ok {
+			if d := next.Sub(s.now()); d < wait {
+				wait = max(d, 0)
+			}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		now := s.now()
+		s.check(last, now)
+		last = now
+	}
+}
+
+// check fires the rules and alarms due in (from, to]. A rule or alarm due
+// several times, after a suspend, fires once.
+func (s *Scheduler) check(from, to time.Time) {
+	if s.alarm != nil {
+		for _, f := range s.manager.DueAlarms(from, to) {
+			s.alarm(f)
+		}
+	}
+	for _, n := range s.next(from) {
+		if n.At.After(to) {
+			break
+		}
+		s.fire(n)
+	}
+}
@@ -0,0 +1,190 @@
+package timezone
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNotificationRule_Next(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		rule    NotificationRule
+		holiday HolidayFunc
+		after   time.Time
+		want    time.Time
+	}{
+		{
+			name:  "Time of day later today",
+			rule:  NotificationRule{Zone: "America/New_York", Kind: RuleTimeOfDay, Time: "17:00"},
+			after: time.Date(2025, 3, 4, 10, 0, 0, 0, newYork),
+			want:  time.Date(2025, 3, 4, 17, 0, 0, 0, newYork),
+		},
+		{
+			// Clocks go forward on 2025-03-09, 17:00 is 21:00 UTC instead of 22:00
+			name:  "Time of day across DST",
+			rule:  NotificationRule{Zone: "America/New_York", Kind: RuleTimeOfDay, Time: "17:00"},
+			after: time.Date(2025, 3, 8, 18, 0, 0, 0, newYork),
+			want:  time.Date(2025, 3, 9, 21, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Work start skips the weekend",
+			rule:  NotificationRule{Zone: "America/New_York", Kind: RuleWorkStart},
+			after: time.Date(2025, 3, 7, 12, 0, 0, 0, newYork),
+			want:  time.Date(2025, 3, 10, 9, 0, 0, 0, newYork),
+		},
+		{
+			name: "Work start skips holidays",
+			rule: NotificationRule{Zone: "America/New_York", Kind: RuleWorkStart},
+			holiday: func(day time.Time) bool {
+				return day.Format("2006-01-02") == "2025-03-10"
+			},
+			after: time.Date(2025, 3, 7, 12, 0, 0, 0, newYork),
+			want:  time.Date(2025, 3, 11, 9, 0, 0, 0, newYork),
+		},
+		{
+			name:  "Work end",
+			rule:  NotificationRule{Zone: "America/New_York", Kind: RuleWorkEnd},
+			after: time.Date(2025, 3, 4, 17, 0, 0, 0, newYork),
+			want:  time.Date(2025, 3, 5, 17, 0, 0, 0, newYork),
+		},
+		{
+			name:  "Weekend starts Saturday midnight",
+			rule:  NotificationRule{Zone: "America/New_York", Kind: RuleWeekendStart},
+			after: time.Date(2025, 3, 4, 10, 0, 0, 0, newYork),
+			want:  time.Date(2025, 3, 8, 0, 0, 0, 0, newYork),
+		},
+		{
+			name: "Weekend starts on a holiday Friday",
+			rule: NotificationRule{Zone: "America/New_York", Kind: RuleWeekendStart},
+			holiday: func(day time.Time) bool {
+				return day.Format("2006-01-02") == "2025-03-07"
+			},
+			after: time.Date(2025, 3, 4, 10, 0, 0, 0, newYork),
+			want:  time.Date(2025, 3, 7, 0, 0, 0, 0, newYork),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rule.Next(tt.after, newYork, DefaultWorkingHours, tt.holiday)
+			if err != nil {
+				t.Fatalf("Next() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotificationRule_Validate(t *testing.T) {
+	tests := []struct {
+		rule    NotificationRule
+		wantErr bool
+	}{
+		{NotificationRule{Zone: "Asia/Tokyo", Kind: RuleWorkStart}, false},
+		{NotificationRule{Zone: "Asia/Tokyo", Kind: RuleTimeOfDay, Time: "8:30"}, false},
+		{NotificationRule{Zone: "Asia/Tokyo", Kind: RuleTimeOfDay}, true},
+		{NotificationRule{Zone: "Asia/Tokyo", Kind: "lunch"}, true},
+		{NotificationRule{Zone: "Mars/Olympus", Kind: RuleWorkStart}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.rule.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%+v) error = %v, wantErr %v", tt.rule, err, tt.wantErr)
+		}
+	}
+}
+
+func TestScheduler_Check(t *testing.T) {
+	manager, err := NewManagerFromConfig(TimeZoneConfig{
+		Local: TimeZoneEntry{Zone: "Europe/London", Description: "London"},
+		Others: []TimeZoneEntry{
+			{Zone: "Asia/Tokyo", Description: "Tokyo team", WorkingHours: &WorkingHours{Start: "10:00", End: "18:00"}},
+		},
+		Notifications: []NotificationRule{
+			{Zone: "Asia/Tokyo", Kind: RuleWorkStart},
+			{Zone: "Europe/London", Kind: RuleTimeOfDay, Time: "12:00", Label: "Lunch"},
+			{Zone: "Europe/London", Kind: RuleWeekendStart, Disabled: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+
+	var fired []string
+	s := NewScheduler(manager, func(n Notification) {
+		fired = append(fired, n.Text)
+	})
+
+	// Tuesday 2025-03-04 10:00 in Tokyo is 01:00 in London
+	from := time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)
+	s.check(from, from.Add(2*time.Hour))
+	if len(fired) != 1 || fired[0] != "Tokyo team's day starts" {
+		t.Errorf("fired %q, want the Tokyo work start", fired)
+	}
+
+	// A suspend over several days fires each rule once
+	fired = nil
+	s.check(from, from.AddDate(0, 0, 3))
+	if len(fired) != 2 {
+		t.Errorf("fired %q, want one notification per enabled rule", fired)
+	}
+}
+
+func TestScheduler_CheckSkipsFailingRule(t *testing.T) {
+	// Valid config, but the Tokyo weekend rule can't fire: no days means
+	// every day is worked
+	cfg := TimeZoneConfig{
+		Local: TimeZoneEntry{Zone: "Europe/Lisbon", Description: "Lisbon"},
+		Others: []TimeZoneEntry{
+			{Zone: "Asia/Tokyo", Description: "Tokyo", WorkingHours: &WorkingHours{Start: "09:00", End: "17:00"}},
+		},
+		Notifications: []NotificationRule{
+			{Zone: "Asia/Tokyo", Kind: RuleWeekendStart},
+			{Zone: "Europe/Lisbon", Kind: RuleTimeOfDay, Time: "17:00"},
+		},
+	}
+	manager, err := NewManagerFromConfig(TimeZoneConfig{Local: cfg.Local})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+	// Bypass validation, which rejects the rule, as an older config would
+	manager.config = cfg
+
+	next, err := manager.NextNotifications(time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC))
+	if err == nil {
+		t.Error("NextNotifications() error = nil, want the Tokyo rule's error")
+	}
+	if len(next) != 1 || next[0].Text != "Lisbon is at 17:00" {
+		t.Errorf("NextNotifications() = %+v, want the Lisbon rule", next)
+	}
+
+	var fired []string
+	var reported []error
+	s := NewScheduler(manager, func(n Notification) {
+		fired = append(fired, n.Text)
+	})
+	s.OnError(func(err error) {
+		reported = append(reported, err)
+	})
+	from := time.Date(2025, 3, 4, 12, 0, 0, 0, time.UTC)
+	s.check(from, from.Add(6*time.Hour))
+	s.check(from, from.Add(6*time.Hour))
+	if len(fired) != 2 {
+		t.Errorf("fired %q, want the Lisbon rule on each check", fired)
+	}
+	if len(reported) != 1 {
+		t.Errorf("reported %v, want the Tokyo rule once", reported)
+	}
+
+	issues := cfg.Validate()
+	if !issues.HasErrors() || !strings.Contains(issues.String(), "timeZones.notifications[0]") {
+		t.Errorf("Validate() = %v, want weekendStart rejected for Tokyo", issues)
+	}
+}
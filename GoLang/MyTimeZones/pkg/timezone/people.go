@@ -0,0 +1,131 @@
+package timezone
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Person is a colleague living in an IANA zone
+type Person struct {
+	Name         string        `json:"name"`
+	Email        string        `json:"email,omitempty"`
+	Zone         string        `json:"zone"`
+	WorkingHours *WorkingHours `json:"workingHours,omitempty"`
+	Notes        string        `json:"notes,omitempty"`
+	// Holidays names the public holiday set of the person, such as "PT"
+	Holidays string `json:"holidays,omitempty"`
+}
+
+func (p Person) clone() Person {
+	p.WorkingHours = p.WorkingHours.clone()
+	return p
+}
+
+// PersonInfo is the local time of a person at a given instant
+type PersonInfo struct {
+	Name        string    `json:"name"`
+	Zone        string    `json:"zone"`
+	Notes       string    `json:"notes,omitempty"`
+	Date        string    `json:"date"`
+	Time        string    `json:"time"`
+	Diff        string    `json:"diff"`
+	Status      string    `json:"status,omitempty"`
+	Instant     time.Time `json:"instant"`
+	DiffSeconds int       `json:"diffSeconds"`
+}
+
+// calendarFor returns the working hours and holiday set of a person: their
+// own, or those of a configured entry in the same zone
+func (c TimeZoneConfig) calendarFor(p Person) (*WorkingHours, string) {
+	hours, holidays := p.WorkingHours, p.Holidays
+	for _, e := range append([]TimeZoneEntry{c.Local}, c.Others...) {
+		if e.Zone != p.Zone {
+			continue
+		}
+		if hours == nil {
+			hours = e.WorkingHours
+		}
+		if holidays == "" {
+			holidays = e.Holidays
+		}
+	}
+	return hours, holidays
+}
+
+// GetPeopleAt returns the local time of every configured person at the given
+// instant, sorted by zone and name. The clock of a zone is computed once,
+// however many people share it.
+func (m *Manager) GetPeopleAt(at time.Time) ([]PersonInfo, error) {
+	cfg := m.GetConfig()
+	holidays := m.Holidays()
+	localLoc, err := m.location(cfg.Local.Zone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load local timezone: %w", err)
+	}
+	_, localOffset := at.In(localLoc).Zone()
+
+	type clock struct {
+		loc  *time.Location
+		time time.Time
+		diff int
+	}
+	clocks := make(map[string]clock)
+
+	people := make([]PersonInfo, 0, len(cfg.People))
+	for _, p := range cfg.People {
+		c, ok := clocks[p.Zone]
+		if !ok {
+			loc, err := m.location(p.Zone)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load timezone %s of %s: %w", p.Zone, p.Name, err)
+			}
+			t := at.In(loc)
+			_, offset := t.Zone()
+			c = clock{loc: loc, time: t, diff: offset - localOffset}
+			clocks[p.Zone] = c
+		}
+
+		info := PersonInfo{
+			Name:        p.Name,
+			Zone:        p.Zone,
+			Notes:       p.Notes,
+			Date:        c.time.Format("2006-01-02"),
+			Time:        c.time.Format("15:04:05"),
+			Diff:        formatOffset(c.diff),
+			Instant:     c.time,
+			DiffSeconds: c.diff,
+		}
+		if hours, set := cfg.calendarFor(p); hours != nil {
+			info.Status = hours.StatusExcept(at, c.loc, holidayFunc(holidays, set))
+		}
+		people = append(people, info)
+	}
+
+	sort.SliceStable(people, func(i, j int) bool {
+		if people[i].Zone != people[j].Zone {
+			return people[i].Zone < people[j].Zone
+		}
+		return strings.ToLower(people[i].Name) < strings.ToLower(people[j].Name)
+	})
+	return people, nil
+}
+
+// location loads a zone once and caches it for the lifetime of the manager
+func (m *Manager) location(zone string) (*time.Location, error) {
+	m.locMu.Lock()
+	defer m.locMu.Unlock()
+	if loc, ok := m.locations[zone]; ok {
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, err
+	}
+	if m.locations == nil {
+		m.locations = make(map[string]*time.Location)
+	}
+	m.locations[zone] = loc
+	return loc, nil
+}
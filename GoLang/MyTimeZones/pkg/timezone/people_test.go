@@ -0,0 +1,80 @@
+package timezone
+
+import (
+	"testing"
+)
+
+func TestManager_GetPeopleAt(t *testing.T) {
+	manager, err := NewManagerFromConfig(TimeZoneConfig{
+		Local: TimeZoneEntry{Zone: "Europe/Lisbon", Description: "Lisbon"},
+		Others: []TimeZoneEntry{
+			{Zone: "Asia/Tokyo", Description: "Tokyo", WorkingHours: &WorkingHours{Start: "09:00", End: "18:00"}},
+		},
+		People: []Person{
+			{Name: "Kenji", Zone: "Asia/Tokyo"},
+			{Name: "Ana", Zone: "Europe/Lisbon", Notes: "design"},
+			{Name: "aiko", Zone: "Asia/Tokyo", WorkingHours: &WorkingHours{Start: "13:00", End: "22:00"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+
+	// 2025-03-26 10:00 in Tokyo, a Wednesday
+	at, err := ParseTimeIn("2025-03-26 01:00", "Europe/Lisbon")
+	if err != nil {
+		t.Fatalf("ParseTimeIn() error = %v", err)
+	}
+	people, err := manager.GetPeopleAt(at)
+	if err != nil {
+		t.Fatalf("GetPeopleAt() error = %v", err)
+	}
+
+	want := []struct {
+		name, time, diff, status string
+	}{
+		{"aiko", "10:00:00", "+09:00", "starts in 3h"},
+		{"Kenji", "10:00:00", "+09:00", "working"},
+		{"Ana", "01:00:00", "+00:00", ""},
+	}
+	if len(people) != len(want) {
+		t.Fatalf("GetPeopleAt() returned %d people, want %d", len(people), len(want))
+	}
+	for n, w := range want {
+		p := people[n]
+		if p.Name != w.name || p.Time != w.time || p.Diff != w.diff || p.Status != w.status {
+			t.Errorf("people[%d] = %s %s %s %q, want %s %s %s %q", n, p.Name, p.Time, p.Diff, p.Status, w.name, w.time, w.diff, w.status)
+		}
+	}
+}
+
+func TestValidate_People(t *testing.T) {
+	cfg := TimeZoneConfig{
+		Local: TimeZoneEntry{Zone: "Europe/Lisbon", Description: "Lisbon"},
+		People: []Person{
+			{Name: "Ana", Zone: "Europe/Lisbon"},
+			{Name: "", Zone: "Mars/Olympus"},
+			{Name: "Kenji", Zone: "", WorkingHours: &WorkingHours{Start: "25:00", End: "18:00"}},
+		},
+	}
+
+	issues := cfg.Validate()
+	fields := make(map[string]Severity)
+	for _, i := range issues {
+		fields[i.Field] = i.Severity
+	}
+	want := map[string]Severity{
+		"timeZones.people[1].name":         SeverityWarning,
+		"timeZones.people[1].zone":         SeverityError,
+		"timeZones.people[2].zone":         SeverityError,
+		"timeZones.people[2].workingHours": SeverityError,
+	}
+	for field, severity := range want {
+		if fields[field] != severity {
+			t.Errorf("issue on %s = %q, want %q", field, fields[field], severity)
+		}
+	}
+	if len(issues) != len(want) {
+		t.Errorf("Validate() = %d issues, want %d:\n%s", len(issues), len(want), issues)
+	}
+}
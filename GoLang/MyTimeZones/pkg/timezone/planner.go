@@ -0,0 +1,137 @@
+package timezone
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// MeetingRequest describes the day and meeting length to plan for
+type MeetingRequest struct {
+	// Date is the day to plan. Only its calendar date is used, taken as a
+	// day in the Local zone of the config.
+	Date time.Time
+	// Duration is the meeting length, defaults to one hour
+	Duration time.Duration
+	// Step is the spacing between candidate start times, defaults to 30 minutes
+	Step time.Duration
+	// Hours overrides the working hours per zone name. Zones without an
+	// override use their configured hours, or DefaultWorkingHours.
+	Hours map[string]WorkingHours
+	// Holidays are the calendars entries refer to. Manager.PlanMeeting
+	// uses the manager's when nil.
+	Holidays Holidays
+}
+
+// ZoneAvailability is the state of one configured zone during a slot
+type ZoneAvailability struct {
+	Zone        string
+	Description string
+	Start       time.Time
+	End         time.Time
+	Working     bool
+}
+
+// MeetingSlot is a candidate meeting time and how well it suits every zone
+type MeetingSlot struct {
+	Start   time.Time
+	End     time.Time
+	Score   int
+	Total   int
+	Details []ZoneAvailability
+}
+
+// Outside returns the descriptions of the zones outside working hours
+func (s MeetingSlot) Outside() []string {
+	var names []string
+	for _, d := range s.Details {
+		if !d.Working {
+			names = append(names, d.Description)
+		}
+	}
+	return names
+}
+
+// PlanMeeting ranks candidate slots on the requested day by how many
+// configured zones are inside their working hours, public holidays count
+// as non-working days
+func (m *Manager) PlanMeeting(req MeetingRequest) ([]MeetingSlot, error) {
+	if req.Holidays == nil {
+		req.Holidays = m.Holidays()
+	}
+	return PlanMeeting(m.GetConfig(), req)
+}
+
+// PlanMeeting ranks candidate slots for cfg, see Manager.PlanMeeting
+func PlanMeeting(cfg TimeZoneConfig, req MeetingRequest) ([]MeetingSlot, error) {
+	if req.Duration <= 0 {
+		req.Duration = time.Hour
+	}
+	if req.Step <= 0 {
+		req.Step = 30 * time.Minute
+	}
+
+	localLoc, err := time.LoadLocation(cfg.Local.Zone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load local timezone: %w", err)
+	}
+
+	entries := append([]TimeZoneEntry{cfg.Local}, cfg.Others...)
+	locations := make([]*time.Location, len(entries))
+	hours := make([]WorkingHours, len(entries))
+	holidays := make([]HolidayFunc, len(entries))
+	for i, entry := range entries {
+		loc, err := time.LoadLocation(entry.Zone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load timezone %s: %w", entry.Zone, err)
+		}
+		locations[i] = loc
+		holidays[i] = holidayFunc(req.Holidays, entry.Holidays)
+
+		hours[i] = DefaultWorkingHours
+		if entry.WorkingHours != nil {
+			hours[i] = *entry.WorkingHours
+		}
+		if h, ok := req.Hours[entry.Zone]; ok {
+			hours[i] = h
+		}
+		if err := hours[i].Validate(); err != nil {
+			return nil, fmt.Errorf("working hours for %s: %w", entry.Zone, err)
+		}
+	}
+
+	y, mo, d := req.Date.Date()
+	dayStart := time.Date(y, mo, d, 0, 0, 0, 0, localLoc)
+	dayEnd := time.Date(y, mo, d+1, 0, 0, 0, 0, localLoc)
+
+	var slots []MeetingSlot
+	for start := dayStart; !start.Add(req.Duration).After(dayEnd); start = start.Add(req.Step) {
+		end := start.Add(req.Duration)
+		slot := MeetingSlot{
+			Start:   start,
+			End:     end,
+			Total:   len(entries),
+			Details: make([]ZoneAvailability, len(entries)),
+		}
+		for i, entry := range entries {
+			working := hours[i].ContainsExcept(start, end, locations[i], holidays[i])
+			if working {
+				slot.Score++
+			}
+			slot.Details[i] = ZoneAvailability{
+				Zone:        entry.Zone,
+				Description: entry.Description,
+				Start:       start.In(locations[i]),
+				End:         end.In(locations[i]),
+				Working:     working,
+			}
+		}
+		slots = append(slots, slot)
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		return slots[i].Score > slots[j].Score
+	})
+
+	return slots, nil
+}
@@ -0,0 +1,44 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+)
+
+func TestManager_PlanMeeting(t *testing.T) {
+	manager, err := NewManagerFromConfig(TimeZoneConfig{
+		Local: TimeZoneEntry{Zone: "Europe/London", Description: "London"},
+		Others: []TimeZoneEntry{
+			{Zone: "America/New_York", Description: "New York"},
+			{Zone: "Asia/Tokyo", Description: "Tokyo"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+
+	slots, err := manager.PlanMeeting(MeetingRequest{
+		Date:     time.Date(2025, 6, 11, 12, 0, 0, 0, time.UTC),
+		Duration: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("PlanMeeting() error = %v", err)
+	}
+
+	if len(slots) != 47 {
+		t.Fatalf("PlanMeeting() returned %d slots, want 47", len(slots))
+	}
+
+	// London and New York overlap 14:00-17:00 London time; Tokyo is asleep
+	best := slots[0]
+	if best.Score != 2 {
+		t.Errorf("best slot score = %d, want 2", best.Score)
+	}
+	london, _ := time.LoadLocation("Europe/London")
+	if got := best.Start.In(london).Format("15:04"); got != "14:00" {
+		t.Errorf("best slot starts at %s London time, want 14:00", got)
+	}
+	if outside := best.Outside(); len(outside) != 1 || outside[0] != "Tokyo" {
+		t.Errorf("Outside() = %v, want [Tokyo]", outside)
+	}
+}
@@ -0,0 +1,184 @@
+package timezone
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SearchResult is a zone found by ZoneIndex.Search, with why it matched
+type SearchResult struct {
+	Zone   string
+	Reason string
+	rank   int
+	// deprecated aliases come after canonical zones of the same rank
+	deprecated bool
+}
+
+// Search ranks, best first
+const (
+	rankExact = iota // the zone, a city or an alias
+	rankCountry
+	rankAbbreviation
+	rankOffset
+	rankPrefix
+	rankSubstring
+)
+
+// offsetQuery matches offsets such as "+05:30", "-3", "UTC+2" or "GMT -0800"
+var offsetQuery = regexp.MustCompile(`^(?i:UTC|GMT)?\s*([+-])\s*(\d{1,2})(?::?(\d{2}))?$`)
+
+// ZoneIndex searches zones by name, city, country, abbreviation and UTC
+// offset. Abbreviations and offsets are those in effect at the instant the
+// index was built.
+type ZoneIndex struct {
+	entries []indexEntry
+}
+
+type indexEntry struct {
+	zone      string
+	names     []string // the city of the zone and its aliases
+	countries []string
+	comment   string
+	abbr      string
+	offset    int
+	// deprecated is set on backward compatible links, see CanonicalZone
+	deprecated bool
+}
+
+// NewZoneIndex indexes every zone of GetTimeZones at the given instant
+func NewZoneIndex(at time.Time) *ZoneIndex {
+	aliases := make(map[string][]string)
+	for city, zone := range cityAliases {
+		aliases[zone] = append(aliases[zone], city)
+	}
+
+	x := &ZoneIndex{}
+	for _, zone := range GetTimeZones() {
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			continue
+		}
+		abbr, offset := at.In(loc).Zone()
+		city := strings.ReplaceAll(zone[strings.LastIndex(zone, "/")+1:], "_", " ")
+		canonical, deprecated := CanonicalZone(zone)
+		names := append([]string{city}, aliases[canonical]...)
+		sort.Strings(names[1:])
+		info, _ := LookupZone(zone)
+		x.entries = append(x.entries, indexEntry{
+			zone:      zone,
+			names:     names,
+			countries: info.Countries,
+			comment:   info.Comment,
+			abbr:      abbr,
+			offset:    offset,
+
+			deprecated: deprecated,
+		})
+	}
+	return x
+}
+
+// Search returns the zones matching query, best matches first, then
+// canonical zones before deprecated aliases, then by name. An empty query
+// returns every zone.
+func (x *ZoneIndex) Search(query string) []SearchResult {
+	query = strings.TrimSpace(query)
+	results := make([]SearchResult, 0, len(x.entries))
+	if query == "" {
+		for _, e := range x.entries {
+			results = append(results, SearchResult{Zone: e.zone})
+		}
+		return results
+	}
+
+	offset, isOffset := parseOffsetQuery(query)
+	lower := strings.ToLower(strings.ReplaceAll(query, "_", " "))
+	for _, e := range x.entries {
+		var best *SearchResult
+		consider := func(rank int, format string, args ...interface{}) {
+			if best == nil || rank < best.rank {
+				best = &SearchResult{Zone: e.zone, Reason: fmt.Sprintf(format, args...), rank: rank, deprecated: e.deprecated}
+			}
+		}
+
+		zone := strings.ToLower(strings.ReplaceAll(e.zone, "_", " "))
+		switch {
+		case zone == lower:
+			consider(rankExact, "zone")
+		case strings.HasPrefix(zone, lower):
+			consider(rankPrefix, "zone")
+		case strings.Contains(zone, lower):
+			consider(rankSubstring, "zone")
+		}
+		for n, name := range e.names {
+			reason := "city"
+			if n > 0 {
+				reason = "city " + name
+			}
+			switch folded := strings.ToLower(name); {
+			case folded == lower:
+				consider(rankExact, "%s", reason)
+			case strings.HasPrefix(folded, lower):
+				consider(rankPrefix, "%s", reason)
+			case n > 0 && len(lower) >= 3 && strings.Contains(folded, lower):
+				consider(rankSubstring, "%s", reason)
+			}
+		}
+		for _, code := range e.countries {
+			name, _ := CountryName(code)
+			switch folded := strings.ToLower(name); {
+			case folded == lower || strings.EqualFold(code, query):
+				consider(rankCountry, "country %s (%s)", name, code)
+			case len(lower) >= 3 && strings.HasPrefix(folded, lower):
+				consider(rankPrefix, "country %s (%s)", name, code)
+			}
+		}
+		if len(lower) >= 3 && strings.Contains(strings.ToLower(e.comment), lower) {
+			consider(rankSubstring, "%s", e.comment)
+		}
+		// Numeric abbreviations such as "+04" are offsets, not names
+		if !strings.HasPrefix(e.abbr, "+") && !strings.HasPrefix(e.abbr, "-") && strings.EqualFold(e.abbr, query) {
+			consider(rankAbbreviation, "abbreviation %s", e.abbr)
+		}
+		if isOffset && e.offset == offset {
+			consider(rankOffset, "offset UTC%s", formatOffset(offset))
+		}
+
+		if best != nil {
+			results = append(results, *best)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].rank != results[j].rank {
+			return results[i].rank < results[j].rank
+		}
+		if results[i].deprecated != results[j].deprecated {
+			return !results[i].deprecated
+		}
+		return results[i].Zone < results[j].Zone
+	})
+	return results
+}
+
+// parseOffsetQuery parses a signed UTC offset into seconds
+func parseOffsetQuery(query string) (int, bool) {
+	m := offsetQuery.FindStringSubmatch(query)
+	if m == nil {
+		return 0, false
+	}
+	hours, _ := strconv.Atoi(m[2])
+	minutes, _ := strconv.Atoi(m[3])
+	if hours > 14 || minutes >= 60 {
+		return 0, false
+	}
+	offset := hours*3600 + minutes*60
+	if m[1] == "-" {
+		offset = -offset
+	}
+	return offset, true
+}
@@ -0,0 +1,57 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+)
+
+func TestZoneIndex_Search(t *testing.T) {
+	// In January, India is on IST and New York on EST
+	index := NewZoneIndex(time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		query      string
+		wantFirst  string
+		wantReason string
+		wantAlso   []string
+	}{
+		{query: "Mumbai", wantFirst: "Asia/Kolkata", wantReason: "city Mumbai"},
+		{query: "india", wantFirst: "Asia/Kolkata", wantReason: "country India (IN)"},
+		// IST is both India and Israel Standard Time
+		{query: "IST", wantFirst: "Asia/Jerusalem", wantReason: "abbreviation IST", wantAlso: []string{"Asia/Kolkata"}},
+		{query: "+05:30", wantFirst: "Asia/Colombo", wantReason: "offset UTC+05:30", wantAlso: []string{"Asia/Kolkata"}},
+		{query: "UTC-5", wantFirst: "America/Atikokan", wantReason: "offset UTC-05:00", wantAlso: []string{"America/New_York"}},
+		{query: "new york", wantFirst: "America/New_York", wantReason: "city"},
+		{query: "Europe/Lisbon", wantFirst: "Europe/Lisbon", wantReason: "zone"},
+		{query: "US", wantFirst: "America/Adak", wantReason: "country United States (US)", wantAlso: []string{"America/New_York", "Pacific/Honolulu"}},
+		{query: "St Paul", wantFirst: "Indian/Maldives", wantReason: "Kerguelen, St Paul I, Amsterdam I"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			results := index.Search(tt.query)
+			if len(results) == 0 {
+				t.Fatalf("Search(%q) found nothing", tt.query)
+			}
+			if results[0].Zone != tt.wantFirst || results[0].Reason != tt.wantReason {
+				t.Errorf("Search(%q)[0] = %s (%s), want %s (%s)", tt.query, results[0].Zone, results[0].Reason, tt.wantFirst, tt.wantReason)
+			}
+			for _, zone := range tt.wantAlso {
+				found := false
+				for _, r := range results {
+					found = found || r.Zone == zone
+				}
+				if !found {
+					t.Errorf("Search(%q) is missing %s", tt.query, zone)
+				}
+			}
+		})
+	}
+
+	if all := index.Search(""); len(all) != len(index.entries) {
+		t.Errorf("Search(\"\") returned %d zones, want %d", len(all), len(index.entries))
+	}
+	if none := index.Search("Atlantis"); len(none) != 0 {
+		t.Errorf("Search(\"Atlantis\") = %v, want nothing", none)
+	}
+}
@@ -0,0 +1,52 @@
+package timezone
+
+import (
+	"time"
+
+	"github.com/yourusername/MyTimeZones/pkg/solar"
+)
+
+// Place returns the coordinates of the entry: its own, or those of the
+// principal city of its zone. It returns nil for zones such as Etc/UTC.
+func (e TimeZoneEntry) Place() *Coordinates {
+	if e.Coordinates != nil {
+		return e.Coordinates
+	}
+	info, _ := LookupZone(e.Zone)
+	return info.Coordinates
+}
+
+// sun returns the daylight phase of the entry at an instant and its next
+// sunrise or sunset, such as "sunset in 1h20m"
+func (e TimeZoneEntry) sun(at time.Time, loc *time.Location) (string, string) {
+	place := e.Place()
+	if place == nil {
+		return "", ""
+	}
+	phase := solar.PhaseAt(at, place.Latitude, place.Longitude)
+
+	// The next event is today or tomorrow, unless the sun stays up or down
+	local := at.In(loc)
+	var polar string
+	for offset := 0; offset < 2; offset++ {
+		day := solar.Times(local.AddDate(0, 0, offset), place.Latitude, place.Longitude)
+		switch {
+		case day.PolarDay:
+			polar = "midnight sun"
+		case day.PolarNight:
+			polar = "polar night"
+		}
+		var next time.Time
+		var event string
+		if day.Sunrise.After(at) {
+			next, event = day.Sunrise, "sunrise"
+		}
+		if day.Sunset.After(at) && (next.IsZero() || day.Sunset.Before(next)) {
+			next, event = day.Sunset, "sunset"
+		}
+		if !next.IsZero() {
+			return string(phase), event + " in " + formatWait(next.Sub(at))
+		}
+	}
+	return string(phase), polar
+}
@@ -0,0 +1,37 @@
+package timezone
+
+import "testing"
+
+func TestGetTimeInfoAt_Sun(t *testing.T) {
+	manager, err := NewManagerFromConfig(TimeZoneConfig{
+		Local: TimeZoneEntry{Zone: "Europe/Oslo", Description: "Oslo"},
+		Others: []TimeZoneEntry{
+			{Zone: "Europe/Oslo", Description: "Tromso", Coordinates: &Coordinates{Latitude: 69.65, Longitude: 18.96}},
+			{Zone: "Etc/UTC", Description: "UTC"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+
+	// Oslo sets at 15:12 on the winter solstice, Tromso sees no sun at all
+	at, _ := ParseTimeIn("2025-12-21 14:00", "Europe/Oslo")
+	timeInfo, err := manager.GetTimeInfoAt(at)
+	if err != nil {
+		t.Fatalf("GetTimeInfoAt() error = %v", err)
+	}
+
+	tests := []struct {
+		daylight string
+		sun      string
+	}{
+		{"day", "sunset in 1h12m"},
+		{"night", "polar night"},
+		{"", ""},
+	}
+	for n, tt := range tests {
+		if timeInfo[n].Daylight != tt.daylight || timeInfo[n].Sun != tt.sun {
+			t.Errorf("%s: Daylight, Sun = %q, %q, want %q, %q", timeInfo[n].Description, timeInfo[n].Daylight, timeInfo[n].Sun, tt.daylight, tt.sun)
+		}
+	}
+}
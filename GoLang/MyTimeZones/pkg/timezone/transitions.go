@@ -0,0 +1,99 @@
+package timezone
+
+import (
+	"fmt"
+	"time"
+)
+
+// transitionHorizon is how far ahead transitions are searched for
+const transitionHorizon = 2 * 366 * 24 * time.Hour
+
+// Transition is a change of UTC offset. For relative changes the offsets
+// are the difference to the Local zone instead of to UTC.
+type Transition struct {
+	At        time.Time `json:"at"`
+	OldOffset int       `json:"oldOffset"`
+	NewOffset int       `json:"newOffset"`
+}
+
+// String formats the transition as "2025-03-30 +00:00 -> +01:00"
+func (t Transition) String() string {
+	return t.At.Format("2006-01-02") + " " + t.Offsets()
+}
+
+// Offsets formats the change of offset as "+00:00 -> +01:00"
+func (t Transition) Offsets() string {
+	return formatOffset(t.OldOffset) + " -> " + formatOffset(t.NewOffset)
+}
+
+// Within reports whether the transition happens between from and from+d
+func (t Transition) Within(from time.Time, d time.Duration) bool {
+	return !t.At.Before(from) && t.At.Before(from.Add(d))
+}
+
+// NextTransition returns the first change of UTC offset in loc after the
+// given instant, or nil when the zone has no upcoming change
+func NextTransition(loc *time.Location, after time.Time) *Transition {
+	limit := after.Add(transitionHorizon)
+	t := after.In(loc)
+	_, offset := t.Zone()
+	for t.Before(limit) {
+		_, end := t.ZoneBounds()
+		if end.IsZero() {
+			return nil
+		}
+		t = end.In(loc)
+		// Some transitions only change the abbreviation, skip those
+		if _, newOffset := t.Zone(); newOffset != offset {
+			return &Transition{At: t, OldOffset: offset, NewOffset: newOffset}
+		}
+	}
+	return nil
+}
+
+// NextRelativeTransition returns the first instant after the given one at
+// which the offset between loc and localLoc changes
+func NextRelativeTransition(localLoc, loc *time.Location, after time.Time) *Transition {
+	limit := after.Add(transitionHorizon)
+	t := after
+	diff := relativeOffset(localLoc, loc, t)
+	for t.Before(limit) {
+		next := earliest(NextTransition(localLoc, t), NextTransition(loc, t))
+		if next == nil {
+			return nil
+		}
+		t = next.At
+		if newDiff := relativeOffset(localLoc, loc, t); newDiff != diff {
+			return &Transition{At: t, OldOffset: diff, NewOffset: newDiff}
+		}
+	}
+	return nil
+}
+
+func relativeOffset(localLoc, loc *time.Location, at time.Time) int {
+	_, localOffset := at.In(localLoc).Zone()
+	_, otherOffset := at.In(loc).Zone()
+	return otherOffset - localOffset
+}
+
+func earliest(a, b *Transition) *Transition {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case b.At.Before(a.At):
+		return b
+	default:
+		return a
+	}
+}
+
+// NextTransition returns the next offset change of a zone after the given instant
+func (m *Manager) NextTransition(zone string, after time.Time) (*Transition, error) {
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load timezone %s: %w", zone, err)
+	}
+	return NextTransition(loc, after), nil
+}
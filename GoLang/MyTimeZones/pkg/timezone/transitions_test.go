@@ -0,0 +1,46 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextTransition(t *testing.T) {
+	lisbon, _ := time.LoadLocation("Europe/Lisbon")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tr := NextTransition(lisbon, from)
+	if tr == nil {
+		t.Fatal("NextTransition() returned nil for Europe/Lisbon")
+	}
+	want := time.Date(2025, 3, 30, 1, 0, 0, 0, time.UTC)
+	if !tr.At.Equal(want) || tr.OldOffset != 0 || tr.NewOffset != 3600 {
+		t.Errorf("NextTransition() = %v at %v, want +00:00 -> +01:00 at %v", tr, tr.At.UTC(), want)
+	}
+
+	if tr := NextTransition(tokyo, from); tr != nil {
+		t.Errorf("NextTransition() for Asia/Tokyo = %v, want nil", tr)
+	}
+}
+
+func TestNextRelativeTransition(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+	newYork, _ := time.LoadLocation("America/New_York")
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	// The US moves to DST on March 9th, three weeks before Europe
+	tr := NextRelativeTransition(london, newYork, from)
+	if tr == nil {
+		t.Fatal("NextRelativeTransition() returned nil")
+	}
+	if got := tr.At.In(newYork).Format("2006-01-02"); got != "2025-03-09" {
+		t.Errorf("relative change on %s, want 2025-03-09", got)
+	}
+	if tr.OldOffset != -5*3600 || tr.NewOffset != -4*3600 {
+		t.Errorf("relative change %v, want -05:00 -> -04:00", tr)
+	}
+	if !tr.Within(from, 14*24*time.Hour) {
+		t.Errorf("Within(14 days) = false, want true")
+	}
+}
@@ -0,0 +1,153 @@
+package timezone
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Severity tells whether an Issue makes a configuration unusable
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Issue is a single problem found while validating a configuration
+type Issue struct {
+	Severity Severity `json:"severity"`
+	Field    string   `json:"field"`
+	Message  string   `json:"message"`
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Severity, i.Field, i.Message)
+}
+
+// Issues is every problem found in a configuration
+type Issues []Issue
+
+// Add appends an issue with a formatted message
+func (is *Issues) Add(severity Severity, field, format string, args ...interface{}) {
+	*is = append(*is, Issue{Severity: severity, Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// HasErrors reports whether any issue is an error rather than a warning
+func (is Issues) HasErrors() bool {
+	for _, i := range is {
+		if i.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns a *ValidationError holding every issue when there is at least
+// one error, or nil when there are only warnings
+func (is Issues) Err() error {
+	if !is.HasErrors() {
+		return nil
+	}
+	return &ValidationError{Issues: is}
+}
+
+func (is Issues) String() string {
+	lines := make([]string, len(is))
+	for n, i := range is {
+		lines[n] = i.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ValidationError reports every problem of an invalid configuration at once
+type ValidationError struct {
+	Issues Issues
+}
+
+func (e *ValidationError) Error() string {
+	var errs []string
+	for _, i := range e.Issues {
+		if i.Severity == SeverityError {
+			errs = append(errs, i.Field+": "+i.Message)
+		}
+	}
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return fmt.Sprintf("%d problems: %s", len(errs), strings.Join(errs, "; "))
+}
+
+// Validate checks every entry, person, notification rule and alarm of the
+// configuration. Unknown zones, bad working hours and bad rules are errors;
+// deprecated aliases, duplicates, empty descriptions and empty names are
+// warnings.
+func (c TimeZoneConfig) Validate() Issues {
+	var issues Issues
+	seen := make(map[string]string)
+
+	check := func(field string, entry TimeZoneEntry) {
+		switch {
+		case strings.TrimSpace(entry.Zone) == "":
+			issues.Add(SeverityError, field+".zone", "zone is empty")
+		default:
+			if _, err := time.LoadLocation(entry.Zone); err != nil {
+				issues.Add(SeverityError, field+".zone", "unknown IANA zone %q", entry.Zone)
+			} else if canonical, ok := CanonicalZone(entry.Zone); ok {
+				issues.Add(SeverityWarning, field+".zone", "%q is a deprecated alias of %q", entry.Zone, canonical)
+			}
+			if previous, ok := seen[entry.Zone]; ok {
+				issues.Add(SeverityWarning, field+".zone", "%q is already configured at %s", entry.Zone, previous)
+			} else {
+				seen[entry.Zone] = field
+			}
+		}
+
+		if strings.TrimSpace(entry.Description) == "" {
+			issues.Add(SeverityWarning, field+".description", "description is empty")
+		}
+		if entry.WorkingHours != nil {
+			if err := entry.WorkingHours.Validate(); err != nil {
+				issues.Add(SeverityError, field+".workingHours", "%v", err)
+			}
+		}
+		if c := entry.Coordinates; c != nil && (c.Latitude < -90 || c.Latitude > 90 || c.Longitude < -180 || c.Longitude > 180) {
+			issues.Add(SeverityError, field+".coordinates", "%g, %g is not a latitude and longitude", c.Latitude, c.Longitude)
+		}
+	}
+
+	check("timeZones.local", c.Local)
+	for n, entry := range c.Others {
+		check(fmt.Sprintf("timeZones.others[%d]", n), entry)
+	}
+	for n, p := range c.People {
+		field := fmt.Sprintf("timeZones.people[%d]", n)
+		if strings.TrimSpace(p.Name) == "" {
+			issues.Add(SeverityWarning, field+".name", "name is empty")
+		}
+		if _, err := time.LoadLocation(p.Zone); err != nil || strings.TrimSpace(p.Zone) == "" {
+			issues.Add(SeverityError, field+".zone", "unknown IANA zone %q", p.Zone)
+		} else if canonical, ok := CanonicalZone(p.Zone); ok {
+			issues.Add(SeverityWarning, field+".zone", "%q is a deprecated alias of %q", p.Zone, canonical)
+		}
+		if p.WorkingHours != nil {
+			if err := p.WorkingHours.Validate(); err != nil {
+				issues.Add(SeverityError, field+".workingHours", "%v", err)
+			}
+		}
+	}
+	for n, r := range c.Notifications {
+		field := fmt.Sprintf("timeZones.notifications[%d]", n)
+		if err := r.Validate(); err != nil {
+			issues.Add(SeverityError, field, "%v", err)
+		} else if r.Kind == RuleWeekendStart && !c.HoursFor(r.Zone).hasWeekend() {
+			issues.Add(SeverityError, field, "%s never fires, %s works every day", r.Kind, r.Zone)
+		}
+	}
+	for n, a := range c.Alarms {
+		if err := a.Validate(); err != nil {
+			issues.Add(SeverityError, fmt.Sprintf("timeZones.alarms[%d]", n), "%v", err)
+		}
+	}
+	return issues
+}
@@ -0,0 +1,40 @@
+package timezone
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTimeZoneConfig_Validate(t *testing.T) {
+	cfg := TimeZoneConfig{
+		Local: TimeZoneEntry{Zone: "Europe/Lisbon", Description: "Home"},
+		Others: []TimeZoneEntry{
+			{Zone: "Asia/Calcutta", Description: "Office"},
+			{Zone: "Mars/Olympus_Mons", Description: "Rover"},
+			{Zone: "Europe/Lisbon", Description: ""},
+			{Zone: "Asia/Tokyo", Description: "Tokyo", WorkingHours: &WorkingHours{Start: "9", End: "17:00"}},
+		},
+	}
+
+	issues := cfg.Validate()
+	want := map[string]Severity{
+		"timeZones.others[0].zone":         SeverityWarning,
+		"timeZones.others[1].zone":         SeverityError,
+		"timeZones.others[2].zone":         SeverityWarning,
+		"timeZones.others[2].description":  SeverityWarning,
+		"timeZones.others[3].workingHours": SeverityError,
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("Validate() found %d issues, want %d:\n%s", len(issues), len(want), issues)
+	}
+	for _, issue := range issues {
+		if want[issue.Field] != issue.Severity {
+			t.Errorf("unexpected issue %s", issue)
+		}
+	}
+
+	var validationErr *ValidationError
+	if err := issues.Err(); !errors.As(err, &validationErr) {
+		t.Errorf("Err() = %v, want a *ValidationError", err)
+	}
+}
@@ -0,0 +1,197 @@
+package timezone
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// WorkingHours describes the part of the day a zone is considered available
+type WorkingHours struct {
+	Start string   `json:"start"`
+	End   string   `json:"end"`
+	Days  []string `json:"days,omitempty"`
+}
+
+var DefaultWorkingHours = WorkingHours{
+	Start: "09:00",
+	End:   "17:00",
+	Days:  []string{"Mon", "Tue", "Wed", "Thu", "Fri"},
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ParseClock parses an "HH:MM" string into minutes since midnight
+func ParseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// ParseWeekday parses a weekday name such as "Mon" or "monday"
+func ParseWeekday(s string) (time.Weekday, error) {
+	key := strings.ToLower(strings.TrimSpace(s))
+	if len(key) >= 3 {
+		if day, ok := weekdayNames[key[:3]]; ok {
+			return day, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %q", s)
+}
+
+// Validate checks that the start, end and day names can be parsed
+func (h WorkingHours) Validate() error {
+	start, err := ParseClock(h.Start)
+	if err != nil {
+		return err
+	}
+	end, err := ParseClock(h.End)
+	if err != nil {
+		return err
+	}
+	if start == end {
+		return fmt.Errorf("working hours start and end are both %s", h.Start)
+	}
+	for _, d := range h.Days {
+		if _, err := ParseWeekday(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// worksOn reports whether day is a working day. No days means every day.
+func (h WorkingHours) worksOn(day time.Weekday) bool {
+	if len(h.Days) == 0 {
+		return true
+	}
+	for _, d := range h.Days {
+		if wd, err := ParseWeekday(d); err == nil && wd == day {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *WorkingHours) clone() *WorkingHours {
+	if h == nil {
+		return nil
+	}
+	c := *h
+	c.Days = slices.Clone(h.Days)
+	return &c
+}
+
+// hasWeekend reports whether at least one day of the week is not worked
+func (h WorkingHours) hasWeekend() bool {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if !h.worksOn(day) {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains reports whether the interval [start, end) falls entirely inside
+// the working hours, evaluated in the wall clock of loc. Shifts that end
+// past midnight (e.g. 22:00-06:00) are attributed to the day they start on.
+func (h WorkingHours) Contains(start, end time.Time, loc *time.Location) bool {
+	return h.ContainsExcept(start, end, loc, nil)
+}
+
+// ContainsExcept is Contains with the days holiday reports as non-working
+func (h WorkingHours) ContainsExcept(start, end time.Time, loc *time.Location, holiday HolidayFunc) bool {
+	startMin, err := ParseClock(h.Start)
+	if err != nil {
+		return false
+	}
+	endMin, err := ParseClock(h.End)
+	if err != nil {
+		return false
+	}
+
+	s := start.In(loc)
+	// Check the shift starting on the same day and the one starting the day
+	// before, which matters for overnight shifts.
+	for _, dayOffset := range []int{0, -1} {
+		day := time.Date(s.Year(), s.Month(), s.Day()+dayOffset, 0, 0, 0, 0, loc)
+		if !h.worksOn(day.Weekday()) || (holiday != nil && holiday(day)) {
+			continue
+		}
+		shiftStart := time.Date(day.Year(), day.Month(), day.Day(), startMin/60, startMin%60, 0, 0, loc)
+		shiftEnd := time.Date(day.Year(), day.Month(), day.Day(), endMin/60, endMin%60, 0, 0, loc)
+		if endMin <= startMin {
+			shiftEnd = time.Date(day.Year(), day.Month(), day.Day()+1, endMin/60, endMin%60, 0, 0, loc)
+		}
+		if !start.Before(shiftStart) && !end.After(shiftEnd) {
+			return true
+		}
+	}
+	return false
+}
+
+// Status describes availability at the given instant: "working",
+// "weekend", "starts in 2h" when the next shift is close, or "off hours"
+func (h WorkingHours) Status(at time.Time, loc *time.Location) string {
+	return h.StatusExcept(at, loc, nil)
+}
+
+// StatusExcept is Status with the days holiday reports as non-working,
+// described as "holiday"
+func (h WorkingHours) StatusExcept(at time.Time, loc *time.Location, holiday HolidayFunc) string {
+	// A one nanosecond interval so the end of the shift counts as off
+	if h.ContainsExcept(at, at.Add(time.Nanosecond), loc, holiday) {
+		return "working"
+	}
+
+	local := at.In(loc)
+	startMin, err := ParseClock(h.Start)
+	if err != nil {
+		return ""
+	}
+	next := time.Date(local.Year(), local.Month(), local.Day(), startMin/60, startMin%60, 0, 0, loc)
+	if !next.After(local) {
+		next = time.Date(local.Year(), local.Month(), local.Day()+1, startMin/60, startMin%60, 0, 0, loc)
+	}
+	if h.worksOn(next.Weekday()) && (holiday == nil || !holiday(next)) {
+		if wait := next.Sub(at); wait <= statusLookahead {
+			return "starts in " + formatWait(wait)
+		}
+	}
+
+	if holiday != nil && holiday(local) {
+		return "holiday"
+	}
+	if !h.worksOn(local.Weekday()) {
+		return "weekend"
+	}
+	return "off hours"
+}
+
+// statusLookahead is how far ahead Status announces the next shift
+const statusLookahead = 3 * time.Hour
+
+func formatWait(d time.Duration) string {
+	d = d.Round(time.Minute)
+	hours := int(d / time.Hour)
+	minutes := int((d % time.Hour) / time.Minute)
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dh%02dm", hours, minutes)
+	}
+}
@@ -0,0 +1,81 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorkingHours_Contains(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Lisbon")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		hours WorkingHours
+		start time.Time
+		want  bool
+	}{
+		{
+			name:  "Inside office hours",
+			hours: DefaultWorkingHours,
+			start: time.Date(2025, 3, 4, 10, 0, 0, 0, loc),
+			want:  true,
+		},
+		{
+			name:  "Runs past end of day",
+			hours: DefaultWorkingHours,
+			start: time.Date(2025, 3, 4, 16, 30, 0, 0, loc),
+			want:  false,
+		},
+		{
+			name:  "Weekend",
+			hours: DefaultWorkingHours,
+			start: time.Date(2025, 3, 8, 10, 0, 0, 0, loc),
+			want:  false,
+		},
+		{
+			name:  "Overnight shift after midnight",
+			hours: WorkingHours{Start: "22:00", End: "06:00"},
+			start: time.Date(2025, 3, 5, 2, 0, 0, 0, loc),
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.hours.Contains(tt.start, tt.start.Add(time.Hour), loc)
+			if got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkingHours_Status(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want string
+	}{
+		{"Working", time.Date(2025, 3, 4, 11, 0, 0, 0, loc), "working"},
+		{"End of day", time.Date(2025, 3, 4, 17, 0, 0, 0, loc), "off hours"},
+		{"Early morning", time.Date(2025, 3, 4, 7, 0, 0, 0, loc), "starts in 2h"},
+		{"Saturday", time.Date(2025, 3, 8, 10, 0, 0, 0, loc), "weekend"},
+		{"Monday morning", time.Date(2025, 3, 10, 8, 30, 0, 0, loc), "starts in 30m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultWorkingHours.Status(tt.at, loc); got != tt.want {
+				t.Errorf("Status() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package timezone
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ZoneInfo is the tzdata metadata of a zone, see LookupZone
+type ZoneInfo struct {
+	Name string
+	// Countries are the ISO 3166 codes of the countries keeping the time
+	// of the zone, the most populous first
+	Countries []string
+	// Coordinates locate the principal city, nil for zones such as Etc/UTC
+	Coordinates *Coordinates
+	// Comment tells zones of the same country apart, such as "Mountain (most areas)"
+	Comment string
+	// Link is the zone a backward compatible link points at, empty for
+	// canonical zones
+	Link string
+}
+
+// Coordinates are a latitude and longitude in degrees, north and east
+// positive
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// TZDataVersion returns the tzdata release the metadata was generated from
+func TZDataVersion() string {
+	return tzdataVersion
+}
+
+// LookupZone returns the metadata of a zone. A link has the metadata of
+// the zone it points at, with Name the link and Link its target.
+func LookupZone(name string) (ZoneInfo, bool) {
+	info, ok := zoneMetadata[name]
+	if !ok {
+		target, isLink := zoneLinks[name]
+		if !isLink {
+			return ZoneInfo{Name: name}, slices.Contains(timeZoneNames, name)
+		}
+		info = zoneMetadata[target]
+		info.Link = target
+	}
+	info.Name = name
+	return info, true
+}
+
+// Canonical reports whether the zone is a zone of its own rather than a link
+func (z ZoneInfo) Canonical() bool {
+	return z.Link == ""
+}
+
+// CountryNames returns the names of the countries of the zone
+func (z ZoneInfo) CountryNames() []string {
+	names := make([]string, 0, len(z.Countries))
+	for _, code := range z.Countries {
+		if name, ok := CountryName(code); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// CountryName returns the name of an ISO 3166 country code
+func CountryName(code string) (string, bool) {
+	name, ok := countryNames[strings.ToUpper(code)]
+	return name, ok
+}
+
+// ZonesInCountry returns the canonical zones keeping time in a country,
+// sorted by name
+func ZonesInCountry(code string) []string {
+	code = strings.ToUpper(code)
+	var zones []string
+	for name, info := range zoneMetadata {
+		if slices.Contains(info.Countries, code) {
+			zones = append(zones, name)
+		}
+	}
+	sort.Strings(zones)
+	return zones
+}
+
+// CanonicalZone returns the zone a deprecated name links to and true, or the
+// name itself and false. UTC and GMT are links too but are not deprecated,
+// as they are what people expect to type.
+func CanonicalZone(name string) (string, bool) {
+	target, ok := zoneLinks[name]
+	if !ok || name == "UTC" || name == "GMT" {
+		return name, false
+	}
+	if _, own := zoneMetadata[name]; own {
+		return name, false
+	}
+	return target, true
+}
@@ -0,0 +1,69 @@
+package timezone
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLookupZone(t *testing.T) {
+	lisbon, ok := LookupZone("Europe/Lisbon")
+	if !ok || !lisbon.Canonical() {
+		t.Fatalf("LookupZone(Europe/Lisbon) = %+v, %v", lisbon, ok)
+	}
+	if !reflect.DeepEqual(lisbon.Countries, []string{"PT"}) || lisbon.Comment != "Portugal (mainland)" {
+		t.Errorf("Europe/Lisbon = %+v", lisbon)
+	}
+	if c := lisbon.Coordinates; c == nil || c.Latitude < 38.7 || c.Latitude > 38.8 || c.Longitude > -9.1 || c.Longitude < -9.2 {
+		t.Errorf("Europe/Lisbon coordinates = %+v", c)
+	}
+	if names := lisbon.CountryNames(); !reflect.DeepEqual(names, []string{"Portugal"}) {
+		t.Errorf("CountryNames() = %v", names)
+	}
+
+	// A link has the metadata of its target
+	calcutta, ok := LookupZone("Asia/Calcutta")
+	if !ok || calcutta.Canonical() || calcutta.Link != "Asia/Kolkata" || calcutta.Name != "Asia/Calcutta" {
+		t.Errorf("LookupZone(Asia/Calcutta) = %+v, %v", calcutta, ok)
+	}
+	if !reflect.DeepEqual(calcutta.Countries, []string{"IN"}) {
+		t.Errorf("Asia/Calcutta countries = %v", calcutta.Countries)
+	}
+
+	if utc, ok := LookupZone("Etc/UTC"); !ok || utc.Coordinates != nil {
+		t.Errorf("LookupZone(Etc/UTC) = %+v, %v", utc, ok)
+	}
+	if _, ok := LookupZone("Mars/Olympus"); ok {
+		t.Error("LookupZone(Mars/Olympus) found a zone")
+	}
+}
+
+func TestCanonicalZone(t *testing.T) {
+	tests := []struct {
+		name       string
+		want       string
+		deprecated bool
+	}{
+		{"Asia/Calcutta", "Asia/Kolkata", true},
+		{"US/Eastern", "America/New_York", true},
+		{"Asia/Kolkata", "Asia/Kolkata", false},
+		{"UTC", "UTC", false},
+		{"GMT", "GMT", false},
+	}
+	for _, tt := range tests {
+		got, deprecated := CanonicalZone(tt.name)
+		if got != tt.want || deprecated != tt.deprecated {
+			t.Errorf("CanonicalZone(%s) = %s, %v, want %s, %v", tt.name, got, deprecated, tt.want, tt.deprecated)
+		}
+	}
+}
+
+func TestZonesInCountry(t *testing.T) {
+	zones := ZonesInCountry("pt")
+	want := []string{"Atlantic/Azores", "Atlantic/Madeira", "Europe/Lisbon"}
+	if !reflect.DeepEqual(zones, want) {
+		t.Errorf("ZonesInCountry(pt) = %v, want %v", zones, want)
+	}
+	if name, ok := CountryName("jp"); !ok || name != "Japan" {
+		t.Errorf("CountryName(jp) = %q, %v", name, ok)
+	}
+}
@@ -3,6 +3,7 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
@@ -20,20 +21,21 @@ type AddZonesWindow struct {
 	timeManager   *timezone.Manager
 	searchEntry   *widget.Entry
 	description   *widget.Entry
+	workingHours  *workingHoursEditor
+	group         *widget.SelectEntry
+	tags          *widget.Entry
+	holidays      *widget.SelectEntry
 	zonesList     *widget.List
-	filteredZones []string
+	filteredZones []timezone.SearchResult
 	selectedIndex int
-	allZones      []string // Add this field to store all timezones
+	index         *timezone.ZoneIndex
 }
 
 func NewAddZonesWindow(app fyne.App, config *config.AppConfig, timeManager *timezone.Manager) *AddZonesWindow {
-	allZones := timezone.GetTimeZones()
 	return &AddZonesWindow{
 		app:           app,
 		config:        config,
 		timeManager:   timeManager,
-		filteredZones: make([]string, len(allZones)),
-		allZones:      allZones,
 		selectedIndex: -1,
 	}
 }
@@ -50,15 +52,27 @@ func (a *AddZonesWindow) Show() {
 func (a *AddZonesWindow) createUI() {
 	// Search field
 	a.searchEntry = widget.NewEntry()
-	a.searchEntry.SetPlaceHolder("Search timezones...")
+	a.searchEntry.SetPlaceHolder("Search by zone, city, country, abbreviation or offset...")
 	a.searchEntry.OnChanged = a.filterZones
 
 	// Description field
 	a.description = widget.NewEntry()
 	a.description.SetPlaceHolder("Enter description...")
 
+	// Optional working hours
+	a.workingHours = newWorkingHoursEditor()
+
+	// Optional group and tags
+	a.group = widget.NewSelectEntry(a.timeManager.GetConfig().Groups())
+	a.group.SetPlaceHolder("No group")
+	a.tags = widget.NewEntry()
+	a.tags.SetPlaceHolder("Comma separated tags")
+	a.holidays = widget.NewSelectEntry(holidaySets(a.timeManager))
+	a.holidays.SetPlaceHolder("No holidays")
+
 	// Initialize filtered zones with all timezones
-	copy(a.filteredZones, a.allZones)
+	a.index = timezone.NewZoneIndex(time.Now())
+	a.filteredZones = a.index.Search("")
 
 	// Timezone list
 	a.zonesList = widget.NewList(
@@ -66,10 +80,15 @@ func (a *AddZonesWindow) createUI() {
 			return len(a.filteredZones)
 		},
 		func() fyne.CanvasObject {
-			return widget.NewLabel("")
+			return container.NewBorder(nil, nil, nil, widget.NewLabel(""), widget.NewLabel(""))
 		},
 		func(id widget.ListItemID, obj fyne.CanvasObject) {
-			obj.(*widget.Label).SetText(a.filteredZones[id])
+			box := obj.(*fyne.Container)
+			result := a.filteredZones[id]
+			box.Objects[0].(*widget.Label).SetText(result.Zone)
+			reason := box.Objects[1].(*widget.Label)
+			reason.Importance = widget.LowImportance
+			reason.SetText(zoneDetails(result))
 		},
 	)
 
@@ -92,6 +111,11 @@ func (a *AddZonesWindow) createUI() {
 		a.searchEntry,
 		widget.NewLabel("Description"),
 		a.description,
+		widget.NewForm(append(a.workingHours.FormItems(),
+			widget.NewFormItem("Group", a.group),
+			widget.NewFormItem("Tags", a.tags),
+			widget.NewFormItem("Holidays", a.holidays),
+		)...),
 		addButton,
 	)
 
@@ -105,23 +129,26 @@ func (a *AddZonesWindow) createUI() {
 	a.window.SetContent(content)
 }
 
-func (a *AddZonesWindow) filterZones(searchText string) {
-	searchText = strings.ToLower(searchText)
-	a.filteredZones = nil
-
-	if searchText == "" {
-		// If search is empty, show all timezones
-		a.filteredZones = make([]string, len(a.allZones))
-		copy(a.filteredZones, a.allZones)
-	} else {
-		// Filter timezones based on search text
-		for _, tz := range a.allZones {
-			if strings.Contains(strings.ToLower(tz), searchText) {
-				a.filteredZones = append(a.filteredZones, tz)
-			}
-		}
+// zoneDetails is the match reason of a search result, or the countries and
+// comment of the zone when it was not searched for
+func zoneDetails(result timezone.SearchResult) string {
+	if result.Reason != "" {
+		return result.Reason
 	}
+	info, _ := timezone.LookupZone(result.Zone)
+	details := info.CountryNames()
+	if info.Comment != "" {
+		details = append(details, info.Comment)
+	}
+	return strings.Join(details, ", ")
+}
 
+// filterZones ranks the zones matching the search, an empty search shows
+// every zone
+func (a *AddZonesWindow) filterZones(searchText string) {
+	a.filteredZones = a.index.Search(searchText)
+	a.selectedIndex = -1
+	a.zonesList.UnselectAll()
 	a.zonesList.Refresh()
 }
 
@@ -136,12 +163,22 @@ func (a *AddZonesWindow) addSelectedTimezone() {
 		return
 	}
 
+	hours, err := a.workingHours.Get()
+	if err != nil {
+		dialog.ShowError(fmt.Errorf("invalid working hours: %v", err), a.window)
+		return
+	}
+
 	a.config.TimeZones.Others = append(a.config.TimeZones.Others, timezone.TimeZoneEntry{
-		Zone:        a.filteredZones[a.selectedIndex],
-		Description: a.description.Text,
+		Zone:         a.filteredZones[a.selectedIndex].Zone,
+		Description:  a.description.Text,
+		WorkingHours: hours,
+		Group:        strings.TrimSpace(a.group.Text),
+		Tags:         parseTags(a.tags.Text),
+		Holidays:     strings.TrimSpace(a.holidays.Text),
 	})
 
-	if err := a.config.Save("config.json"); err != nil {
+	if err := a.config.Save(a.config.Path()); err != nil {
 		dialog.ShowError(fmt.Errorf("failed to save timezone: %v", err), a.window)
 		return
 	}
@@ -0,0 +1,227 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/yourusername/MyTimeZones/pkg/config"
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// fireAlarm notifies a due alarm and records it as fired. It is called by
+// the scheduler goroutine.
+func (w *Window) fireAlarm(f timezone.AlarmFiring) {
+	w.logger.Info("Alarm: %s", f.Alarm.Name)
+	w.app.SendNotification(fyne.NewNotification(f.Alarm.Name, alarmFiringText(f)))
+	fyne.Do(func() {
+		w.markAlarmsFired([]timezone.AlarmFiring{f})
+	})
+}
+
+func alarmFiringText(f timezone.AlarmFiring) string {
+	loc, err := time.LoadLocation(f.Alarm.Zone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return fmt.Sprintf("%s %s (%s local)", f.At.In(loc).Format("Mon 15:04"), f.Alarm.Zone, f.At.Local().Format("Mon 15:04"))
+}
+
+// checkMissedAlarms reports the alarms due while the application was not
+// running, then records them as fired
+func (w *Window) checkMissedAlarms() {
+	missed := w.timeManager.MissedAlarms(time.Now())
+	if len(missed) == 0 {
+		return
+	}
+	lines := make([]string, len(missed))
+	for n, f := range missed {
+		lines[n] = f.Alarm.Name + ": " + alarmFiringText(f)
+	}
+	dialog.ShowInformation("Missed Alarms", strings.Join(lines, "\n"), w.window)
+	w.markAlarmsFired(missed)
+}
+
+// markAlarmsFired sets LastFired of the alarms that fired and records them
+// in the state file. The config file is left alone, so firings don't rotate
+// its backups nor reload it.
+func (w *Window) markAlarmsFired(fired []timezone.AlarmFiring) {
+	state, err := config.LoadState(w.config.StatePath())
+	if err != nil {
+		w.logger.Error("Failed to load state: %v", err)
+		state = &config.State{}
+	}
+	alarms := append([]timezone.Alarm(nil), w.config.TimeZones.Alarms...)
+	for _, f := range fired {
+		// The alarms may have been edited since the firing was computed
+		if f.Index < len(alarms) && alarms[f.Index].Name == f.Alarm.Name && alarms[f.Index].Zone == f.Alarm.Zone {
+			alarms[f.Index].LastFired = f.At
+			state.RecordAlarm(alarms[f.Index], f.At)
+		}
+	}
+	if err := state.Save(w.config.StatePath()); err != nil {
+		w.logger.Error("Failed to save state: %v", err)
+	}
+
+	tzConfig := w.timeManager.GetConfig()
+	tzConfig.Alarms = alarms
+	if err := w.timeManager.UpdateConfig(tzConfig); err != nil {
+		w.logger.Error("Failed to record fired alarms: %v", err)
+		return
+	}
+	w.config.TimeZones.Alarms = alarms
+	if w.alarmList != nil {
+		w.alarmList.Refresh()
+	}
+}
+
+// showAlarms lists the alarms with their next firing
+func (w *Window) showAlarms() {
+	if w.alarmWindow != nil {
+		w.alarmWindow.Show()
+		w.alarmWindow.RequestFocus()
+		return
+	}
+
+	win := w.app.NewWindow("Alarms")
+	w.alarmWindow = win
+	win.SetOnClosed(func() {
+		w.alarmWindow = nil
+		w.alarmList = nil
+	})
+
+	w.alarmList = widget.NewList(
+		func() int {
+			return len(w.config.TimeZones.Alarms)
+		},
+		func() fyne.CanvasObject {
+			return container.NewBorder(nil, nil, nil,
+				widget.NewButton("Remove", nil),
+				widget.NewLabel(""),
+			)
+		},
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			box := obj.(*fyne.Container)
+			label := box.Objects[0].(*widget.Label)
+			remove := box.Objects[1].(*widget.Button)
+
+			alarm := w.config.TimeZones.Alarms[id]
+			label.SetText(alarmText(alarm))
+			remove.OnTapped = func() {
+				alarms := w.config.TimeZones.Alarms
+				w.saveAlarms(append(alarms[:id:id], alarms[id+1:]...))
+			}
+		},
+	)
+
+	addButton := widget.NewButton("Add Alarm", w.showAddAlarm)
+	win.SetContent(container.NewBorder(container.NewHBox(addButton), nil, nil, nil, w.alarmList))
+	win.Resize(fyne.NewSize(600, 400))
+	win.Show()
+}
+
+// alarmText describes an alarm and its next firing in local time
+func alarmText(alarm timezone.Alarm) string {
+	text := alarm.Name + " - " + alarm.String()
+	next, ok, err := alarm.Next(time.Now())
+	switch {
+	case err != nil:
+		return text + " - " + err.Error()
+	case ok:
+		return text + " - next " + next.Local().Format("Mon 01-02 15:04") + " local"
+	default:
+		return text + " - done"
+	}
+}
+
+func (w *Window) showAddAlarm() {
+	parent := w.alarmWindow
+	if parent == nil {
+		parent = w.window
+	}
+
+	name := widget.NewEntry()
+	name.SetPlaceHolder("Reminder")
+	zone := widget.NewSelectEntry(timezone.GetTimeZones())
+	zone.SetText(w.timeManager.GetConfig().Local.Zone)
+	at := widget.NewEntry()
+	at.SetPlaceHolder("2025-03-10 09:00")
+	clock := widget.NewEntry()
+	clock.SetPlaceHolder("09:00")
+	days := widget.NewCheckGroup(weekdayOptions, nil)
+	days.Horizontal = true
+
+	const oneShot, recurring = "Once", "Repeat"
+	repeat := widget.NewRadioGroup([]string{oneShot, recurring}, func(choice string) {
+		if choice == recurring {
+			at.Disable()
+			clock.Enable()
+			days.Enable()
+		} else {
+			at.Enable()
+			clock.Disable()
+			days.Disable()
+		}
+	})
+	repeat.Horizontal = true
+	repeat.SetSelected(oneShot)
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("Name", name),
+		widget.NewFormItem("Zone", zone),
+		widget.NewFormItem("Repeat", repeat),
+		widget.NewFormItem("Date and time", at),
+		widget.NewFormItem("Time", clock),
+		widget.NewFormItem("Days", days),
+	}
+	dlg := dialog.NewForm("Add Alarm", "Add", "Cancel", items, func(ok bool) {
+		if !ok {
+			return
+		}
+		alarm := timezone.Alarm{
+			Name: strings.TrimSpace(name.Text),
+			Zone: strings.TrimSpace(zone.Text),
+		}
+		if alarm.Name == "" {
+			alarm.Name = "Reminder"
+		}
+		if repeat.Selected == recurring {
+			alarm.Time = strings.TrimSpace(clock.Text)
+			alarm.Days = days.Selected
+			// Only later firings count, earlier ones were never missed
+			alarm.LastFired = time.Now()
+		} else {
+			alarm.At = strings.TrimSpace(at.Text)
+		}
+		if err := alarm.Validate(); err != nil {
+			dialog.ShowError(err, parent)
+			return
+		}
+		w.saveAlarms(append(w.config.TimeZones.Alarms, alarm))
+	}, parent)
+	dlg.Resize(fyne.NewSize(500, 350))
+	dlg.Show()
+}
+
+// saveAlarms stores new alarms, keeping the old ones when they are invalid
+func (w *Window) saveAlarms(alarms []timezone.Alarm) {
+	tzConfig := w.timeManager.GetConfig()
+	tzConfig.Alarms = alarms
+	if err := w.timeManager.UpdateConfig(tzConfig); err != nil {
+		_ = w.timeManager.UpdateConfig(w.config.TimeZones)
+		dialog.ShowError(err, w.window)
+		return
+	}
+	w.config.TimeZones = tzConfig
+	if err := w.config.Save(w.config.Path()); err != nil {
+		dialog.ShowError(fmt.Errorf("failed to save configuration: %v", err), w.window)
+	}
+	if w.alarmList != nil {
+		w.alarmList.Refresh()
+	}
+}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"fyne.io/fyne/v2"
@@ -20,6 +21,10 @@ type EditZonesWindow struct {
 	timeManager        *timezone.Manager
 	localZone          *widget.Entry
 	localDesc          *widget.Entry
+	workingHours       *workingHoursEditor
+	group              *widget.SelectEntry
+	tags               *widget.Entry
+	holidays           *widget.SelectEntry
 	otherZones         *widget.List
 	config             *config.AppConfig
 	selectedOtherIndex int // -1 means editing local zone
@@ -49,8 +54,7 @@ func (e *EditZonesWindow) Show() {
 	} else {
 		// If window already exists, just refresh the list and fields
 		e.otherZones.Refresh()
-		e.localZone.SetText(e.config.TimeZones.Local.Zone)
-		e.localDesc.SetText(e.config.TimeZones.Local.Description)
+		e.setFields(e.config.TimeZones.Local)
 	}
 	e.window.Show()
 }
@@ -59,13 +63,25 @@ func (e *EditZonesWindow) createUI() {
 	// Local timezone section
 	e.localZone = widget.NewEntry()
 	e.localDesc = widget.NewEntry()
-	e.localZone.SetText(e.config.TimeZones.Local.Zone)
-	e.localDesc.SetText(e.config.TimeZones.Local.Description)
+	e.workingHours = newWorkingHoursEditor()
+	e.group = widget.NewSelectEntry(e.config.TimeZones.Groups())
+	e.group.SetPlaceHolder("No group")
+	e.tags = widget.NewEntry()
+	e.tags.SetPlaceHolder("Comma separated tags")
+	e.holidays = widget.NewSelectEntry(nil)
+	e.holidays.SetPlaceHolder("No holidays")
+	e.setFields(e.config.TimeZones.Local)
 
 	localForm := widget.NewForm(
 		widget.NewFormItem("Local Zone", e.localZone),
 		widget.NewFormItem("Description", e.localDesc),
 	)
+	for _, item := range e.workingHours.FormItems() {
+		localForm.AppendItem(item)
+	}
+	localForm.Append("Group", e.group)
+	localForm.Append("Tags", e.tags)
+	localForm.Append("Holidays", e.holidays)
 
 	// Other timezones section as a list
 	e.selectedOtherIndex = -1
@@ -85,21 +101,25 @@ func (e *EditZonesWindow) createUI() {
 			button := box.Objects[1].(*widget.Button)
 
 			tz := e.config.TimeZones.Others[id]
-			label.SetText(fmt.Sprintf("%s - %s", tz.Zone, tz.Description))
+			text := fmt.Sprintf("%s - %s", tz.Zone, tz.Description)
+			if tz.Group != "" {
+				text += fmt.Sprintf(" [%s]", tz.Group)
+			}
+			if len(tz.Tags) > 0 {
+				text += " #" + strings.Join(tz.Tags, " #")
+			}
+			label.SetText(text)
 			button.SetText("Remove")
 			button.OnTapped = func() {
 				e.removeZone(id)
 				e.selectedOtherIndex = -1
-				e.localZone.SetText(e.config.TimeZones.Local.Zone)
-				e.localDesc.SetText(e.config.TimeZones.Local.Description)
+				e.setFields(e.config.TimeZones.Local)
 			}
 		},
 	)
 	e.otherZones.OnSelected = func(id widget.ListItemID) {
 		e.selectedOtherIndex = id
-		tz := e.config.TimeZones.Others[id]
-		e.localZone.SetText(tz.Zone)
-		e.localDesc.SetText(tz.Description)
+		e.setFields(e.config.TimeZones.Others[id])
 	}
 
 	// The scrollable list (will take all remaining space)
@@ -143,10 +163,34 @@ func (e *EditZonesWindow) createUI() {
 	e.window.SetContent(content)
 }
 
+// setFields shows an entry in the edit form
+func (e *EditZonesWindow) setFields(tz timezone.TimeZoneEntry) {
+	e.localZone.SetText(tz.Zone)
+	e.localDesc.SetText(tz.Description)
+	e.workingHours.Set(tz.WorkingHours)
+	e.group.SetOptions(e.config.TimeZones.Groups())
+	e.group.SetText(tz.Group)
+	e.tags.SetText(strings.Join(tz.Tags, ", "))
+	e.holidays.SetOptions(holidaySets(e.timeManager))
+	e.holidays.SetText(tz.Holidays)
+}
+
+// holidaySets lists the loaded holiday sets to pick from
+func holidaySets(timeManager *timezone.Manager) []string {
+	if holidays := timeManager.Holidays(); holidays != nil {
+		return holidays.Sets()
+	}
+	return nil
+}
+
 func (e *EditZonesWindow) removeZone(index int) {
 	e.config.TimeZones.Others = append(e.config.TimeZones.Others[:index], e.config.TimeZones.Others[index+1:]...)
-	_ = e.config.Save("config.json")
-	_ = e.timeManager.UpdateConfig(e.config.TimeZones)
+	if err := e.config.Save(e.config.Path()); err != nil {
+		dialog.ShowError(fmt.Errorf("failed to save configuration: %v", err), e.window)
+	}
+	if err := e.timeManager.UpdateConfig(e.config.TimeZones); err != nil {
+		dialog.ShowError(fmt.Errorf("failed to update manager: %v", err), e.window)
+	}
 	e.otherZones.Refresh()
 }
 
@@ -156,18 +200,25 @@ func (e *EditZonesWindow) saveChanges() {
 		dialog.ShowError(fmt.Errorf("invalid timezone: %v", err), e.window)
 		return
 	}
+	hours, err := e.workingHours.Get()
+	if err != nil {
+		dialog.ShowError(fmt.Errorf("invalid working hours: %v", err), e.window)
+		return
+	}
 
+	// Update the selected other zone, or the local zone
+	entry := &e.config.TimeZones.Local
 	if e.selectedOtherIndex >= 0 && e.selectedOtherIndex < len(e.config.TimeZones.Others) {
-		// Update selected other zone
-		e.config.TimeZones.Others[e.selectedOtherIndex].Zone = e.localZone.Text
-		e.config.TimeZones.Others[e.selectedOtherIndex].Description = e.localDesc.Text
-	} else {
-		// Update local zone
-		e.config.TimeZones.Local.Zone = e.localZone.Text
-		e.config.TimeZones.Local.Description = e.localDesc.Text
+		entry = &e.config.TimeZones.Others[e.selectedOtherIndex]
 	}
-
-	if err := e.config.Save("config.json"); err != nil {
+	entry.Zone = e.localZone.Text
+	entry.Description = e.localDesc.Text
+	entry.WorkingHours = hours
+	entry.Group = strings.TrimSpace(e.group.Text)
+	entry.Tags = parseTags(e.tags.Text)
+	entry.Holidays = strings.TrimSpace(e.holidays.Text)
+
+	if err := e.config.Save(e.config.Path()); err != nil {
 		dialog.ShowError(fmt.Errorf("failed to save configuration: %v", err), e.window)
 		return
 	}
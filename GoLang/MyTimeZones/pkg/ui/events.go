@@ -0,0 +1,261 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/yourusername/MyTimeZones/pkg/ical"
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// eventRow is one occurrence with its start in every configured zone
+type eventRow struct {
+	occurrence ical.Occurrence
+	zones      []timezone.TimeInfo
+}
+
+// calendarFile is the loaded .ics file, reread when it changes on disk or
+// the Local zone its floating times are read in changes. A file that fails
+// to parse is kept with its error, so it is only parsed again once changed.
+type calendarFile struct {
+	path     string
+	modTime  time.Time
+	local    string
+	events   []ical.Event
+	warnings []string
+	err      error
+}
+
+func (w *Window) createEventsView() fyne.CanvasObject {
+	w.eventsStatus = widget.NewLabel("")
+	w.eventsTable = widget.NewTable(
+		func() (int, int) {
+			return len(w.eventRows) + 1, len(w.eventZones) + 2
+		},
+		func() fyne.CanvasObject {
+			return widget.NewLabel("Template")
+		},
+		func(i widget.TableCellID, o fyne.CanvasObject) {
+			label := o.(*widget.Label)
+			label.Importance = widget.MediumImportance
+			if i.Row == 0 {
+				switch i.Col {
+				case 0:
+					label.SetText("When")
+				case 1:
+					label.SetText("Event")
+				default:
+					label.SetText(w.eventZones[i.Col-2].Description)
+				}
+				return
+			}
+
+			row := w.eventRows[i.Row-1]
+			switch i.Col {
+			case 0:
+				label.SetText(formatEventTime(row.occurrence, row.zones[0].Instant.Location(), w.now()))
+			case 1:
+				label.SetText(row.occurrence.Summary)
+			default:
+				if i.Col-2 >= len(row.zones) {
+					label.SetText("")
+					return
+				}
+				info := row.zones[i.Col-2]
+				text := info.Instant.Format("Mon 15:04")
+				if isNight(info.Instant) {
+					label.Importance = widget.DangerImportance
+					text += " (night)"
+				} else if info.Status != "" && info.Status != "working" {
+					label.Importance = widget.WarningImportance
+				}
+				label.SetText(text)
+			}
+		},
+	)
+	w.eventsTable.SetColumnWidth(0, 170)
+	w.eventsTable.SetColumnWidth(1, 220)
+
+	openButton := widget.NewButton("Open Calendar…", w.showOpenCalendar)
+	return container.NewBorder(container.NewHBox(openButton, w.eventsStatus), nil, nil, nil, w.eventsTable)
+}
+
+// isNight reports whether a local time falls between 22:00 and 07:00
+func isNight(t time.Time) bool {
+	return t.Hour() >= 22 || t.Hour() < 7
+}
+
+func formatEventTime(o ical.Occurrence, loc *time.Location, now time.Time) string {
+	if o.AllDay {
+		return o.Start.Format("Mon 2006-01-02") + " all day"
+	}
+	start := o.Start.In(loc)
+	day := func(t time.Time) string { return t.Format("2006-01-02") }
+	switch day(start) {
+	case day(now.In(loc)):
+		return "Today " + start.Format("15:04")
+	case day(now.In(loc).AddDate(0, 0, 1)):
+		return "Tomorrow " + start.Format("15:04")
+	default:
+		return start.Format("Mon 01-02 15:04")
+	}
+}
+
+// eventsKey is what the event rows depend on: the calendar file, the day
+// they start on in the Local zone, the number of days and the zones. err
+// is the error shown instead of the rows.
+type eventsKey struct {
+	path    string
+	modTime time.Time
+	day     time.Time
+	days    int
+	zones   string
+	err     string
+}
+
+// updateEvents rereads the calendar file when it changed and recomputes the
+// occurrences from the start of today, in the Local zone, for the configured
+// number of days. Expanding the rules is only done when the key changes, so
+// it reports whether the rows changed.
+func (w *Window) updateEvents() bool {
+	cfg := w.timeManager.GetConfig()
+	zones := append([]timezone.TimeZoneEntry{cfg.Local}, cfg.Others...)
+
+	path := w.config.CalendarPath()
+	zonesJSON, _ := json.Marshal(zones)
+	key := eventsKey{path: path, days: w.config.Calendar.Days, zones: string(zonesJSON)}
+	if path == "" {
+		return w.setEventsError(key, zones, "No calendar, open an .ics file to see its events in every zone")
+	}
+	loc, err := time.LoadLocation(cfg.Local.Zone)
+	if err != nil {
+		loc = time.Local
+	}
+	if err := w.loadCalendar(path, loc); err != nil {
+		key.err = err.Error()
+		return w.setEventsError(key, zones, key.err)
+	}
+
+	now := w.now().In(loc)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	key.modTime = w.calendar.modTime
+	key.day = from
+	if key == w.eventsKey {
+		return false
+	}
+
+	occurrences, err := ical.Occurrences(w.calendar.events, from, from.AddDate(0, 0, w.config.Calendar.Days))
+	if err != nil {
+		key.err = err.Error()
+		return w.setEventsError(key, zones, key.err)
+	}
+	var rows []eventRow
+	for _, o := range occurrences {
+		info, err := w.timeManager.GetTimeInfoAt(o.Start)
+		if err != nil {
+			key.err = err.Error()
+			return w.setEventsError(key, zones, key.err)
+		}
+		rows = append(rows, eventRow{occurrence: o, zones: info})
+	}
+	status := fmt.Sprintf("%d events in the next %d days from %s", len(rows), w.config.Calendar.Days, path)
+	if len(w.calendar.warnings) > 0 {
+		status += "; " + strings.Join(w.calendar.warnings, "; ")
+	}
+	w.setEvents(key, zones, rows, status)
+	return true
+}
+
+// setEvents stores the rows computed for key
+func (w *Window) setEvents(key eventsKey, zones []timezone.TimeZoneEntry, rows []eventRow, status string) {
+	w.eventsKey = key
+	w.eventZones = zones
+	w.eventRows = rows
+	w.eventsStatus.SetText(status)
+}
+
+// setEventsError shows status instead of the rows, reporting whether that
+// changed anything
+func (w *Window) setEventsError(key eventsKey, zones []timezone.TimeZoneEntry, status string) bool {
+	if key == w.eventsKey {
+		return false
+	}
+	if key.err != "" {
+		w.logger.Error("Calendar: %s", key.err)
+	}
+	w.setEvents(key, zones, nil, status)
+	return true
+}
+
+// loadCalendar reads the calendar with its floating times in local
+func (w *Window) loadCalendar(path string, local *time.Location) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if w.calendar != nil && w.calendar.path == path && w.calendar.modTime.Equal(info.ModTime()) && w.calendar.local == local.String() {
+		return w.calendar.err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	cal, err := ical.ParseCalendar(file, local)
+	if err != nil {
+		w.calendar = &calendarFile{path: path, modTime: info.ModTime(), local: local.String(), err: fmt.Errorf("%s: %w", path, err)}
+		return w.calendar.err
+	}
+	w.calendar = &calendarFile{path: path, modTime: info.ModTime(), local: local.String(), events: cal.Events, warnings: cal.Warnings}
+	w.logger.Info("Loaded %d events from %s", len(cal.Events), path)
+	for _, warning := range cal.Warnings {
+		w.logger.Error("%s: %s", path, warning)
+	}
+	return nil
+}
+
+// refreshEvents redraws the events table when its rows changed. The
+// relative "Today" and "Tomorrow" labels only change with the day, which is
+// part of the key.
+func (w *Window) refreshEvents() {
+	if !w.updateEvents() {
+		return
+	}
+	for col := 2; col < len(w.eventZones)+2; col++ {
+		w.eventsTable.SetColumnWidth(col, 150)
+	}
+	w.eventsTable.Refresh()
+}
+
+// showOpenCalendar picks the .ics file to show and saves it in the config
+func (w *Window) showOpenCalendar() {
+	open := dialog.NewFileOpen(func(reader fyne.URIReadCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, w.window)
+			return
+		}
+		if reader == nil {
+			return
+		}
+		path := reader.URI().Path()
+		reader.Close()
+
+		w.config.Calendar.Path = path
+		if err := w.config.Save(w.config.Path()); err != nil {
+			dialog.ShowError(fmt.Errorf("failed to save configuration: %v", err), w.window)
+		}
+		w.refresh()
+	}, w.window)
+	open.SetFilter(storage.NewExtensionFileFilter([]string{".ics"}))
+	open.Show()
+}
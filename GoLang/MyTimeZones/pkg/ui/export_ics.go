@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/yourusername/MyTimeZones/pkg/ical"
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// showExportICS asks for the meeting details, prefilled with start in zone,
+// and saves the meeting as an .ics file
+func showExportICS(parent fyne.Window, timeManager *timezone.Manager, zone string, start time.Time, duration time.Duration) {
+	cfg := timeManager.GetConfig()
+	zones := []string{cfg.Local.Zone}
+	for _, tz := range cfg.Others {
+		zones = append(zones, tz.Zone)
+	}
+
+	summary := widget.NewEntry()
+	summary.SetText("Meeting")
+	zoneEntry := widget.NewSelectEntry(zones)
+	zoneEntry.SetText(zone)
+	startEntry := widget.NewEntry()
+	if loc, err := time.LoadLocation(zone); err == nil {
+		startEntry.SetText(start.In(loc).Format("2006-01-02 15:04"))
+	}
+	durationSelect := widget.NewSelect([]string{"30 minutes", "1 hour", "1.5 hours", "2 hours"}, nil)
+	durationSelect.SetSelected("1 hour")
+	for name, d := range meetingDurations {
+		if d == duration {
+			durationSelect.SetSelected(name)
+		}
+	}
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("Title", summary),
+		widget.NewFormItem("Zone", zoneEntry),
+		widget.NewFormItem("Start (YYYY-MM-DD HH:MM)", startEntry),
+		widget.NewFormItem("Duration", durationSelect),
+	}
+	form := dialog.NewForm("Export .ics", "Save…", "Cancel", items, func(ok bool) {
+		if !ok {
+			return
+		}
+		zone := strings.TrimSpace(zoneEntry.Text)
+		at, err := timezone.ParseTimeIn(startEntry.Text, zone)
+		if err != nil {
+			dialog.ShowError(err, parent)
+			return
+		}
+		loc, _ := time.LoadLocation(zone)
+		event, err := ical.NewMeeting(timeManager, summary.Text, at.In(loc), meetingDurations[durationSelect.Selected])
+		if err != nil {
+			dialog.ShowError(err, parent)
+			return
+		}
+		saveICS(parent, event)
+	}, parent)
+	form.Resize(fyne.NewSize(450, 300))
+	form.Show()
+}
+
+func saveICS(parent fyne.Window, event ical.Event) {
+	save := dialog.NewFileSave(func(writer fyne.URIWriteCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, parent)
+			return
+		}
+		if writer == nil {
+			return
+		}
+		if err := ical.Write(writer, event); err != nil {
+			writer.Close()
+			dialog.ShowError(fmt.Errorf("failed to write %s: %v", writer.URI().Name(), err), parent)
+			return
+		}
+		if err := writer.Close(); err != nil {
+			dialog.ShowError(err, parent)
+		}
+	}, parent)
+	save.SetFileName(event.Start.Format("2006-01-02-1504") + ".ics")
+	save.SetFilter(storage.NewExtensionFileFilter([]string{".ics"}))
+	save.Show()
+}
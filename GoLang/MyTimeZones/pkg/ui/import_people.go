@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/yourusername/MyTimeZones/pkg/importer"
+)
+
+// showImportPeople picks a CSV or vCard roster and previews the merge
+func (w *Window) showImportPeople() {
+	open := dialog.NewFileOpen(func(reader fyne.URIReadCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, w.window)
+			return
+		}
+		if reader == nil {
+			return
+		}
+		path := reader.URI().Path()
+		reader.Close()
+
+		result, err := importer.ParseFile(path)
+		if err != nil {
+			dialog.ShowError(err, w.window)
+			return
+		}
+		w.showImportPreview(result)
+	}, w.window)
+	open.SetFilter(storage.NewExtensionFileFilter([]string{".csv", ".vcf", ".vcard"}))
+	open.Show()
+}
+
+// showImportPreview lists what merging the roster changes and merges it on
+// confirmation. A person whose offset several zones use is merged once a
+// zone is picked for them.
+func (w *Window) showImportPreview(result importer.Result) {
+	changes := importer.Diff(w.config.TimeZones.People, result.People)
+	counts := importer.Counts(changes)
+
+	var lines []string
+	for _, change := range changes {
+		lines = append(lines, change.String())
+	}
+	for _, problem := range result.Problems {
+		lines = append(lines, "skipped "+problem.String())
+	}
+	details := widget.NewLabel(strings.Join(lines, "\n"))
+	details.Wrapping = fyne.TextWrapWord
+	summary := widget.NewLabel(fmt.Sprintf("%d to add, %d to update, %d unchanged, %d need a zone, %d skipped",
+		counts[importer.Add], counts[importer.Update], counts[importer.Unchanged], len(result.Choices), len(result.Problems)))
+
+	picks := make([]*widget.Select, len(result.Choices))
+	choices := container.NewVBox()
+	for n, choice := range result.Choices {
+		picks[n] = widget.NewSelect(choice.Zones, nil)
+		picks[n].PlaceHolder = "Pick a zone, or leave to skip"
+		label := widget.NewLabel(fmt.Sprintf("%s (offset %s)", choice.Person.Name, choice.Offset))
+		choices.Add(container.NewBorder(nil, nil, label, nil, picks[n]))
+	}
+
+	content := container.NewBorder(summary, nil, nil, nil, container.NewVScroll(container.NewVBox(choices, details)))
+	dlg := dialog.NewCustomConfirm("Import People", "Merge", "Cancel", content, func(merge bool) {
+		if !merge {
+			return
+		}
+		people := result.People
+		for n, choice := range result.Choices {
+			if picks[n].Selected == "" {
+				continue
+			}
+			person := choice.Person
+			person.Zone = picks[n].Selected
+			people = append(people, person)
+		}
+		w.savePeople(importer.Merge(w.config.TimeZones.People, importer.Diff(w.config.TimeZones.People, people)))
+	}, w.window)
+	dlg.Resize(fyne.NewSize(600, 400))
+	dlg.Show()
+}
@@ -0,0 +1,192 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+type MeetingPlannerWindow struct {
+	app         fyne.App
+	window      fyne.Window
+	timeManager *timezone.Manager
+	dateEntry   *widget.Entry
+	duration    *widget.Select
+	hourEntries map[string]*widget.Entry
+	slotsList   *widget.List
+	slots       []timezone.MeetingSlot
+}
+
+var meetingDurations = map[string]time.Duration{
+	"30 minutes": 30 * time.Minute,
+	"1 hour":     time.Hour,
+	"1.5 hours":  90 * time.Minute,
+	"2 hours":    2 * time.Hour,
+}
+
+func NewMeetingPlannerWindow(app fyne.App, timeManager *timezone.Manager) *MeetingPlannerWindow {
+	return &MeetingPlannerWindow{
+		app:         app,
+		timeManager: timeManager,
+		hourEntries: make(map[string]*widget.Entry),
+	}
+}
+
+func (p *MeetingPlannerWindow) Show() {
+	if p.window == nil {
+		p.window = p.app.NewWindow("Plan Meeting")
+		p.createUI()
+		p.window.Resize(fyne.NewSize(700, 600))
+		p.window.SetOnClosed(func() {
+			p.window = nil
+		})
+	}
+	p.window.Show()
+}
+
+func (p *MeetingPlannerWindow) createUI() {
+	cfg := p.timeManager.GetConfig()
+
+	p.dateEntry = widget.NewEntry()
+	p.dateEntry.SetText(time.Now().Format("2006-01-02"))
+
+	p.duration = widget.NewSelect([]string{"30 minutes", "1 hour", "1.5 hours", "2 hours"}, nil)
+	p.duration.SetSelected("1 hour")
+
+	// One working hours entry per configured zone
+	hoursForm := widget.NewForm()
+	for _, entry := range append([]timezone.TimeZoneEntry{cfg.Local}, cfg.Others...) {
+		if _, ok := p.hourEntries[entry.Zone]; ok {
+			continue
+		}
+		hours := timezone.DefaultWorkingHours
+		if entry.WorkingHours != nil {
+			hours = *entry.WorkingHours
+		}
+		hoursEntry := widget.NewEntry()
+		hoursEntry.SetText(hours.Start + "-" + hours.End)
+		p.hourEntries[entry.Zone] = hoursEntry
+		hoursForm.Append(entry.Description+" ("+entry.Zone+")", hoursEntry)
+	}
+
+	p.slotsList = widget.NewList(
+		func() int {
+			return len(p.slots)
+		},
+		func() fyne.CanvasObject {
+			return widget.NewLabel("")
+		},
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			obj.(*widget.Label).SetText(formatSlot(p.slots[id]))
+		},
+	)
+	p.slotsList.OnSelected = func(id widget.ListItemID) {
+		p.showSlotDetails(p.slots[id])
+	}
+
+	findButton := widget.NewButton("Find Slots", p.findSlots)
+
+	topContent := container.NewVBox(
+		widget.NewForm(
+			widget.NewFormItem("Date (YYYY-MM-DD)", p.dateEntry),
+			widget.NewFormItem("Duration", p.duration),
+		),
+		widget.NewLabel("Working Hours (HH:MM-HH:MM)"),
+		hoursForm,
+		findButton,
+		widget.NewSeparator(),
+	)
+
+	content := container.NewBorder(
+		container.NewVScroll(topContent), // top
+		nil,                              // bottom
+		nil, nil,                         // left, right
+		p.slotsList, // center
+	)
+	p.window.SetContent(content)
+}
+
+func (p *MeetingPlannerWindow) findSlots() {
+	date, err := time.Parse("2006-01-02", strings.TrimSpace(p.dateEntry.Text))
+	if err != nil {
+		dialog.ShowError(fmt.Errorf("invalid date, expected YYYY-MM-DD"), p.window)
+		return
+	}
+
+	days := make(map[string][]string)
+	for _, entry := range p.timeManager.GetConfig().Others {
+		if entry.WorkingHours != nil {
+			days[entry.Zone] = entry.WorkingHours.Days
+		}
+	}
+	if local := p.timeManager.GetConfig().Local; local.WorkingHours != nil {
+		days[local.Zone] = local.WorkingHours.Days
+	}
+
+	hours := make(map[string]timezone.WorkingHours, len(p.hourEntries))
+	for zone, entry := range p.hourEntries {
+		start, end, ok := strings.Cut(entry.Text, "-")
+		if !ok {
+			dialog.ShowError(fmt.Errorf("invalid working hours for %s, expected HH:MM-HH:MM", zone), p.window)
+			return
+		}
+		zoneDays, ok := days[zone]
+		if !ok {
+			zoneDays = timezone.DefaultWorkingHours.Days
+		}
+		hours[zone] = timezone.WorkingHours{
+			Start: strings.TrimSpace(start),
+			End:   strings.TrimSpace(end),
+			Days:  zoneDays,
+		}
+	}
+
+	slots, err := p.timeManager.PlanMeeting(timezone.MeetingRequest{
+		Date:     date,
+		Duration: meetingDurations[p.duration.Selected],
+		Hours:    hours,
+	})
+	if err != nil {
+		dialog.ShowError(err, p.window)
+		return
+	}
+
+	p.slots = slots
+	p.slotsList.UnselectAll()
+	p.slotsList.Refresh()
+}
+
+func (p *MeetingPlannerWindow) showSlotDetails(slot timezone.MeetingSlot) {
+	var lines []string
+	for _, d := range slot.Details {
+		status := "in hours"
+		if !d.Working {
+			status = "outside hours"
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s - %s (%s)",
+			d.Description, d.Start.Format("Mon 15:04"), d.End.Format("15:04"), status))
+	}
+	dlg := dialog.NewCustomConfirm("Meeting Slot", "Export .ics", "Close", widget.NewLabel(strings.Join(lines, "\n")), func(export bool) {
+		if export {
+			zone := p.timeManager.GetConfig().Local.Zone
+			showExportICS(p.window, p.timeManager, zone, slot.Start, slot.End.Sub(slot.Start))
+		}
+	}, p.window)
+	dlg.Show()
+}
+
+func formatSlot(slot timezone.MeetingSlot) string {
+	text := fmt.Sprintf("%s - %s  %d/%d zones in working hours",
+		slot.Start.Format("15:04"), slot.End.Format("15:04"), slot.Score, slot.Total)
+	if outside := slot.Outside(); len(outside) > 0 {
+		text += "  (outside: " + strings.Join(outside, ", ") + ")"
+	}
+	return text
+}
@@ -0,0 +1,186 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// ruleKindNames are the choices of the rule kind select
+var ruleKindNames = map[string]string{
+	timezone.RuleWorkStart:    "Working day starts",
+	timezone.RuleWorkEnd:      "Working day ends",
+	timezone.RuleTimeOfDay:    "Reaches a time of day",
+	timezone.RuleWeekendStart: "Weekend starts",
+}
+
+// startNotifications runs the scheduler of the notification rules and the
+// alarms until the window closes
+func (w *Window) startNotifications() {
+	scheduler := timezone.NewScheduler(w.timeManager, func(n timezone.Notification) {
+		w.logger.Info("Notification: %s", n.Text)
+		w.app.SendNotification(fyne.NewNotification("MyTime", n.Text))
+	})
+	scheduler.OnAlarm(w.fireAlarm)
+	scheduler.OnError(func(err error) {
+		w.logger.Error("Skipping notification rules: %v", err)
+	})
+	go scheduler.Run(w.ctx)
+}
+
+// showNotifications lists the notification rules with their next firing
+func (w *Window) showNotifications() {
+	if w.notifyWindow != nil {
+		w.notifyWindow.Show()
+		w.notifyWindow.RequestFocus()
+		return
+	}
+
+	win := w.app.NewWindow("Notifications")
+	w.notifyWindow = win
+	win.SetOnClosed(func() {
+		w.notifyWindow = nil
+		w.notifyList = nil
+	})
+
+	w.notifyList = widget.NewList(
+		func() int {
+			return len(w.config.TimeZones.Notifications)
+		},
+		func() fyne.CanvasObject {
+			return container.NewBorder(nil, nil,
+				widget.NewCheck("", nil),
+				widget.NewButton("Remove", nil),
+				widget.NewLabel(""),
+			)
+		},
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			box := obj.(*fyne.Container)
+			label := box.Objects[0].(*widget.Label)
+			enabled := box.Objects[1].(*widget.Check)
+			remove := box.Objects[2].(*widget.Button)
+
+			rule := w.config.TimeZones.Notifications[id]
+			label.SetText(w.ruleText(rule))
+			enabled.OnChanged = nil
+			enabled.SetChecked(!rule.Disabled)
+			enabled.OnChanged = func(on bool) {
+				rules := append([]timezone.NotificationRule(nil), w.config.TimeZones.Notifications...)
+				rules[id].Disabled = !on
+				w.saveNotifications(rules)
+			}
+			remove.OnTapped = func() {
+				rules := w.config.TimeZones.Notifications
+				w.saveNotifications(append(rules[:id:id], rules[id+1:]...))
+			}
+		},
+	)
+
+	addButton := widget.NewButton("Add Rule", w.showAddNotification)
+	win.SetContent(container.NewBorder(container.NewHBox(addButton), nil, nil, nil, w.notifyList))
+	win.Resize(fyne.NewSize(600, 400))
+	win.Show()
+}
+
+// ruleText describes a rule and when it fires next
+func (w *Window) ruleText(rule timezone.NotificationRule) string {
+	text := rule.Text(w.timeManager.GetConfig().EntryFor(rule.Zone).Description)
+	if rule.Disabled {
+		return text
+	}
+	// A rule that can't fire is skipped, the others still have a next firing
+	next, _ := w.timeManager.NextNotifications(w.now())
+	for _, n := range next {
+		if n.Rule == rule {
+			return fmt.Sprintf("%s - next %s", text, n.At.Local().Format("Mon 01-02 15:04"))
+		}
+	}
+	return text
+}
+
+func (w *Window) showAddNotification() {
+	parent := w.notifyWindow
+	if parent == nil {
+		parent = w.window
+	}
+
+	cfg := w.timeManager.GetConfig()
+	var zones []string
+	for _, e := range append([]timezone.TimeZoneEntry{cfg.Local}, cfg.Others...) {
+		zones = append(zones, e.Zone)
+	}
+	zone := widget.NewSelectEntry(zones)
+	if len(zones) > 1 {
+		zone.SetText(zones[1])
+	}
+
+	var kindNames []string
+	for _, kind := range timezone.RuleKinds {
+		kindNames = append(kindNames, ruleKindNames[kind])
+	}
+	at := widget.NewEntry()
+	at.SetPlaceHolder("17:00")
+	at.Disable()
+	kind := widget.NewSelect(kindNames, func(name string) {
+		if name == ruleKindNames[timezone.RuleTimeOfDay] {
+			at.Enable()
+		} else {
+			at.Disable()
+		}
+	})
+	kind.SetSelectedIndex(0)
+	label := widget.NewEntry()
+	label.SetPlaceHolder("Zone description")
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("Zone", zone),
+		widget.NewFormItem("When", kind),
+		widget.NewFormItem("Time", at),
+		widget.NewFormItem("Label", label),
+	}
+	dlg := dialog.NewForm("Add Notification", "Add", "Cancel", items, func(ok bool) {
+		if !ok {
+			return
+		}
+		rule := timezone.NotificationRule{
+			Zone:  strings.TrimSpace(zone.Text),
+			Kind:  timezone.RuleKinds[kind.SelectedIndex()],
+			Label: strings.TrimSpace(label.Text),
+		}
+		if rule.Kind == timezone.RuleTimeOfDay {
+			rule.Time = strings.TrimSpace(at.Text)
+		}
+		if err := rule.Validate(); err != nil {
+			dialog.ShowError(err, parent)
+			return
+		}
+		w.saveNotifications(append(w.config.TimeZones.Notifications, rule))
+	}, parent)
+	dlg.Resize(fyne.NewSize(400, 300))
+	dlg.Show()
+}
+
+// saveNotifications stores new rules, keeping the old ones when they are
+// invalid
+func (w *Window) saveNotifications(rules []timezone.NotificationRule) {
+	tzConfig := w.timeManager.GetConfig()
+	tzConfig.Notifications = rules
+	if err := w.timeManager.UpdateConfig(tzConfig); err != nil {
+		_ = w.timeManager.UpdateConfig(w.config.TimeZones)
+		dialog.ShowError(err, w.window)
+		return
+	}
+	w.config.TimeZones = tzConfig
+	if err := w.config.Save(w.config.Path()); err != nil {
+		dialog.ShowError(fmt.Errorf("failed to save configuration: %v", err), w.window)
+	}
+	if w.notifyList != nil {
+		w.notifyList.Refresh()
+	}
+}
@@ -0,0 +1,179 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// personRow is one row of the people list: a zone header or a person
+type personRow struct {
+	zone   string
+	info   *timezone.PersonInfo
+	person int // index in the configured people, for removal
+}
+
+func (r personRow) isHeader() bool {
+	return r.info == nil
+}
+
+func (w *Window) createPeopleView() fyne.CanvasObject {
+	w.peopleList = widget.NewList(
+		func() int {
+			return len(w.peopleRows)
+		},
+		func() fyne.CanvasObject {
+			return container.NewBorder(nil, nil, nil,
+				widget.NewButton("Remove", nil),
+				widget.NewLabel(""),
+			)
+		},
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			box := obj.(*fyne.Container)
+			label := box.Objects[0].(*widget.Label)
+			button := box.Objects[1].(*widget.Button)
+			row := w.peopleRows[id]
+			if row.isHeader() {
+				label.TextStyle = fyne.TextStyle{Bold: true}
+				label.SetText(w.peopleHeaderText(row))
+				button.Hide()
+				return
+			}
+
+			label.TextStyle = fyne.TextStyle{}
+			label.SetText(w.personText(*row.info))
+			button.Show()
+			button.OnTapped = func() {
+				w.removePerson(row.person)
+			}
+		},
+	)
+	w.peopleList.OnSelected = func(id widget.ListItemID) {
+		w.peopleList.UnselectAll()
+	}
+
+	addButton := widget.NewButton("Add Person", w.showAddPerson)
+	return container.NewBorder(container.NewHBox(addButton), nil, nil, nil, w.peopleList)
+}
+
+// updatePeopleRows rebuilds the people list at the displayed instant, with
+// one header per zone followed by the people living there
+func (w *Window) updatePeopleRows() {
+	people, err := w.timeManager.GetPeopleAt(w.now())
+	if err != nil {
+		w.logger.Error("Failed to get people: %v", err)
+		w.peopleRows = nil
+		return
+	}
+
+	// Map people back to their configured index, names may repeat
+	configured := w.timeManager.GetConfig().People
+	used := make([]bool, len(configured))
+	index := func(info timezone.PersonInfo) int {
+		for n, p := range configured {
+			if !used[n] && p.Name == info.Name && p.Zone == info.Zone {
+				used[n] = true
+				return n
+			}
+		}
+		return -1
+	}
+
+	var rows []personRow
+	for n := range people {
+		info := &people[n]
+		if len(rows) == 0 || rows[len(rows)-1].zone != info.Zone {
+			rows = append(rows, personRow{zone: info.Zone})
+		}
+		rows = append(rows, personRow{zone: info.Zone, info: info, person: index(*info)})
+	}
+	w.peopleRows = rows
+}
+
+func (w *Window) peopleHeaderText(header personRow) string {
+	for _, row := range w.peopleRows {
+		if !row.isHeader() && row.zone == header.zone {
+			return fmt.Sprintf("%s  %s %s (%s)", header.zone, row.info.Date, w.formatClock(row.info.Instant), row.info.Diff)
+		}
+	}
+	return header.zone
+}
+
+func (w *Window) personText(info timezone.PersonInfo) string {
+	parts := []string{info.Name}
+	if info.Status != "" {
+		parts = append(parts, info.Status)
+	}
+	if info.Notes != "" {
+		parts = append(parts, info.Notes)
+	}
+	return "    " + strings.Join(parts, " - ")
+}
+
+func (w *Window) showAddPerson() {
+	name := widget.NewEntry()
+	zone := widget.NewSelectEntry(timezone.GetTimeZones())
+	zone.SetText(w.timeManager.GetConfig().Local.Zone)
+	notes := widget.NewEntry()
+	holidays := widget.NewSelectEntry(holidaySets(w.timeManager))
+	holidays.SetPlaceHolder("Same as the zone")
+	hours := newWorkingHoursEditor()
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("Name", name),
+		widget.NewFormItem("Zone", zone),
+		widget.NewFormItem("Notes", notes),
+		widget.NewFormItem("Holidays", holidays),
+	}
+	items = append(items, hours.FormItems()...)
+
+	dlg := dialog.NewForm("Add Person", "Add", "Cancel", items, func(ok bool) {
+		if !ok {
+			return
+		}
+		workingHours, err := hours.Get()
+		if err != nil {
+			dialog.ShowError(fmt.Errorf("invalid working hours: %v", err), w.window)
+			return
+		}
+		w.savePeople(append(w.config.TimeZones.People, timezone.Person{
+			Name:         strings.TrimSpace(name.Text),
+			Zone:         strings.TrimSpace(zone.Text),
+			WorkingHours: workingHours,
+			Notes:        strings.TrimSpace(notes.Text),
+			Holidays:     strings.TrimSpace(holidays.Text),
+		}))
+	}, w.window)
+	dlg.Resize(fyne.NewSize(500, 400))
+	dlg.Show()
+}
+
+func (w *Window) removePerson(index int) {
+	people := w.config.TimeZones.People
+	if index < 0 || index >= len(people) {
+		return
+	}
+	w.savePeople(append(people[:index:index], people[index+1:]...))
+}
+
+// savePeople stores a new people list, keeping the old one when it is invalid
+func (w *Window) savePeople(people []timezone.Person) {
+	tzConfig := w.timeManager.GetConfig()
+	tzConfig.People = people
+	if err := w.timeManager.UpdateConfig(tzConfig); err != nil {
+		_ = w.timeManager.UpdateConfig(w.config.TimeZones)
+		dialog.ShowError(err, w.window)
+		return
+	}
+	w.config.TimeZones = tzConfig
+	if err := w.config.Save(w.config.Path()); err != nil {
+		dialog.ShowError(fmt.Errorf("failed to save configuration: %v", err), w.window)
+	}
+	w.refresh()
+}
@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/yourusername/MyTimeZones/pkg/config"
+)
+
+// showRestoreConfig lists the config backups and restores the chosen one
+func (w *Window) showRestoreConfig() {
+	path := w.config.Path()
+	backups, err := config.ListBackups(path)
+	if err != nil {
+		dialog.ShowError(fmt.Errorf("failed to list backups: %v", err), w.window)
+		return
+	}
+	if len(backups) == 0 {
+		dialog.ShowInformation("Restore Previous Config", "There are no backups of "+path+" yet.", w.window)
+		return
+	}
+
+	selected := -1
+	list := widget.NewList(
+		func() int {
+			return len(backups)
+		},
+		func() fyne.CanvasObject {
+			return widget.NewLabel("")
+		},
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			obj.(*widget.Label).SetText(backups[id].Time.Format("2006-01-02 15:04:05"))
+		},
+	)
+	list.OnSelected = func(id widget.ListItemID) {
+		selected = id
+	}
+
+	content := container.NewBorder(widget.NewLabel("Saved versions, newest first"), nil, nil, nil, list)
+	dlg := dialog.NewCustomConfirm("Restore Previous Config", "Restore", "Cancel", content, func(restore bool) {
+		if !restore {
+			return
+		}
+		if selected < 0 {
+			dialog.ShowError(fmt.Errorf("please select a backup"), w.window)
+			return
+		}
+		cfg, err := config.RestoreBackup(path, backups[selected], w.config.BackupCount)
+		if err != nil {
+			dialog.ShowError(err, w.window)
+			return
+		}
+		w.ReloadConfig(cfg, nil)
+		dialog.ShowInformation("Restore Previous Config", "Restored the config from "+backups[selected].Time.Format("2006-01-02 15:04:05"), w.window)
+	}, w.window)
+	dlg.Resize(fyne.NewSize(400, 400))
+	dlg.Show()
+}
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+// now returns the instant the table is rendered at: the live clock, or the
+// reference instant picked in the time travel bar shifted by the slider
+func (w *Window) now() time.Time {
+	base := w.referenceTime
+	if base.IsZero() {
+		base = time.Now()
+	}
+	return base.Add(w.referenceShift)
+}
+
+// isLive reports whether the table follows the live clock
+func (w *Window) isLive() bool {
+	return w.referenceTime.IsZero() && w.referenceShift == 0
+}
+
+func (w *Window) createTimeTravelBar() fyne.CanvasObject {
+	w.travelZone = widget.NewSelect(nil, nil)
+	w.updateTravelZones()
+
+	w.travelEntry = widget.NewEntry()
+	w.travelEntry.SetPlaceHolder("YYYY-MM-DD HH:MM")
+
+	w.travelSlider = widget.NewSlider(-24, 24)
+	w.travelSlider.Step = 0.25
+	w.travelSlider.OnChanged = func(hours float64) {
+		w.referenceShift = time.Duration(hours * float64(time.Hour))
+		w.refresh()
+	}
+
+	goButton := widget.NewButton("Go", w.applyReferenceTime)
+	liveButton := widget.NewButton("Live", w.resetToLive)
+
+	return container.NewBorder(
+		nil, nil,
+		container.NewHBox(widget.NewLabel("Show time at"), w.travelZone),
+		container.NewHBox(goButton, liveButton),
+		container.NewGridWithColumns(2, w.travelEntry, w.travelSlider),
+	)
+}
+
+// updateTravelZones offers the configured zones in the time travel bar
+func (w *Window) updateTravelZones() {
+	cfg := w.timeManager.GetConfig()
+	zones := []string{cfg.Local.Zone}
+	for _, tz := range cfg.Others {
+		zones = append(zones, tz.Zone)
+	}
+	w.travelZone.Options = zones
+	if w.travelZone.Selected == "" || !slices.Contains(zones, w.travelZone.Selected) {
+		w.travelZone.SetSelected(cfg.Local.Zone)
+	}
+	w.travelZone.Refresh()
+}
+
+func (w *Window) applyReferenceTime() {
+	at, err := timezone.ParseTimeIn(w.travelEntry.Text, w.travelZone.Selected)
+	if err != nil {
+		dialog.ShowError(err, w.window)
+		return
+	}
+	w.referenceTime = at
+	w.referenceShift = 0
+	w.travelSlider.SetValue(0)
+	w.refresh()
+}
+
+func (w *Window) resetToLive() {
+	w.referenceTime = time.Time{}
+	w.referenceShift = 0
+	w.travelEntry.SetText("")
+	w.travelSlider.SetValue(0)
+	w.refresh()
+}
+
+func (w *Window) timeTravelStatus() string {
+	if w.isLive() {
+		return ""
+	}
+	loc, err := time.LoadLocation(w.timeManager.GetConfig().Local.Zone)
+	if err != nil {
+		loc = time.Local
+	}
+	return fmt.Sprintf("  |  Time travel: %s", w.now().In(loc).Format("Mon 2006-01-02 15:04 MST"))
+}
@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/driver/desktop"
+)
+
+// setupTray adds the tray menu when the driver supports one and tray mode
+// is enabled. Closing the window hides it instead of quitting while tray
+// mode is enabled.
+func (w *Window) setupTray() {
+	if tray, ok := w.app.(desktop.App); ok {
+		w.tray = tray
+		w.refreshTray()
+	}
+	w.window.SetCloseIntercept(func() {
+		if w.config.TrayMode && w.tray != nil {
+			w.window.Hide()
+			return
+		}
+		w.close()
+	})
+}
+
+// refreshTray updates the clock of every zone in the tray menu. The menu is
+// only rebuilt when the configured zones change or tray mode is turned on.
+func (w *Window) refreshTray() {
+	if w.tray == nil {
+		return
+	}
+	if !w.config.TrayMode {
+		if w.trayMenu != nil {
+			// Fyne cannot remove a tray icon once shown, so drop the clocks
+			// and leave only Open until the next start
+			w.tray.SetSystemTrayMenu(fyne.NewMenu("MyTime", fyne.NewMenuItem("Open", w.showFromTray)))
+			w.trayMenu, w.trayZones = nil, nil
+		}
+		return
+	}
+	timeInfo, err := w.timeManager.GetTimeInfoAt(time.Now())
+	if err != nil {
+		w.logger.Error("Failed to get time info: %v", err)
+		return
+	}
+
+	zones := make([]string, len(timeInfo))
+	labels := make([]string, len(timeInfo))
+	for n, info := range timeInfo {
+		zones[n] = info.Name
+		labels[n] = fmt.Sprintf("%s  %s", info.Description, info.Instant.Format("Mon 15:04"))
+	}
+
+	if w.trayMenu == nil || !slices.Equal(zones, w.trayZones) {
+		items := make([]*fyne.MenuItem, 0, len(labels)+3)
+		for _, label := range labels {
+			items = append(items, fyne.NewMenuItem(label, w.showFromTray))
+		}
+		items = append(items,
+			fyne.NewMenuItemSeparator(),
+			fyne.NewMenuItem("Open", w.showFromTray),
+			fyne.NewMenuItem("Add Zone…", func() {
+				NewAddZonesWindow(w.app, w.config, w.timeManager).Show()
+			}),
+		)
+		w.trayMenu = fyne.NewMenu("MyTime", items...)
+		w.trayZones = zones
+		w.tray.SetSystemTrayMenu(w.trayMenu)
+		return
+	}
+
+	for n, label := range labels {
+		w.trayMenu.Items[n].Label = label
+	}
+	w.trayMenu.Refresh()
+}
+
+func (w *Window) showFromTray() {
+	w.window.Show()
+	w.window.RequestFocus()
+}
+
+// trayModeMenuItem toggles tray mode and saves the choice
+func (w *Window) trayModeMenuItem() *fyne.MenuItem {
+	item := fyne.NewMenuItem("Minimize to Tray", nil)
+	item.Checked = w.config.TrayMode
+	item.Disabled = w.tray == nil
+	item.Action = func() {
+		w.config.TrayMode = !w.config.TrayMode
+		if err := w.config.Save(w.config.Path()); err != nil {
+			dialog.ShowError(fmt.Errorf("failed to save configuration: %v", err), w.window)
+		}
+		w.refreshTray()
+		w.updateTrayMode()
+	}
+	w.trayModeItem = item
+	return item
+}
+
+// updateTrayMode checks the Minimize to Tray item when tray mode is on,
+// after it was toggled or reloaded from disk. The tray menu follows on the
+// next refreshTray.
+func (w *Window) updateTrayMode() {
+	if w.trayModeItem == nil || w.trayModeItem.Checked == w.config.TrayMode {
+		return
+	}
+	w.trayModeItem.Checked = w.config.TrayMode
+	if menu := w.window.MainMenu(); menu != nil {
+		menu.Refresh()
+	}
+}
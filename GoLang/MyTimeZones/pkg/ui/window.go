@@ -2,14 +2,18 @@ package ui
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/widget"
 
 	"github.com/yourusername/MyTimeZones/pkg/config"
+	"github.com/yourusername/MyTimeZones/pkg/export"
 	"github.com/yourusername/MyTimeZones/pkg/logger"
 	"github.com/yourusername/MyTimeZones/pkg/timezone"
 )
@@ -20,6 +24,7 @@ type Window struct {
 	timeManager *timezone.Manager
 	logger      *logger.Logger
 	refreshRate time.Duration
+	ticker      *time.Ticker
 	showSeconds bool
 	ctx         context.Context
 	cancel      context.CancelFunc
@@ -27,11 +32,57 @@ type Window struct {
 	table       *widget.Table
 	editWindow  *EditZonesWindow
 	config      *config.AppConfig
+	configError string
+	// configWarnings summarizes the warnings of the config in the status bar
+	configWarnings string
+
+	// Time travel state, a zero referenceTime means live
+	referenceTime  time.Time
+	referenceShift time.Duration
+	travelZone     *widget.Select
+	travelEntry    *widget.Entry
+	travelSlider   *widget.Slider
+
+	// Table rows with group headers, collapsed groups and the tag filter
+	rows      []tableRow
+	collapsed map[string]bool
+	tagFilter string
+	tagSelect *widget.Select
+
+	// People tab
+	peopleList *widget.List
+	peopleRows []personRow
+
+	// Events tab, the occurrences of the configured .ics calendar
+	calendar     *calendarFile
+	eventsTable  *widget.Table
+	eventsStatus *widget.Label
+	eventRows    []eventRow
+	eventsKey    eventsKey
+	eventZones   []timezone.TimeZoneEntry
+
+	// Map tab
+	worldMap *worldMap
+
+	// System tray, nil when the driver has none
+	tray      desktop.App
+	trayMenu  *fyne.Menu
+	trayZones []string
+	// trayModeItem is the Minimize to Tray check item of the File menu
+	trayModeItem *fyne.MenuItem
+
+	// Notification rules window, nil when closed
+	notifyWindow fyne.Window
+	notifyList   *widget.List
+
+	// Alarms window, nil when closed
+	alarmWindow fyne.Window
+	alarmList   *widget.List
 }
 
-func NewWindow(app fyne.App, config *config.AppConfig, timeManager *timezone.Manager, logger *logger.Logger, refreshRateSeconds int, showSeconds bool) *Window {
-	if refreshRateSeconds < 1 {
-		refreshRateSeconds = 1
+func NewWindow(app fyne.App, config *config.AppConfig, timeManager *timezone.Manager, logger *logger.Logger, refreshRate time.Duration, showSeconds bool) *Window {
+	if refreshRate < time.Second {
+		refreshRate = time.Second
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Window{
@@ -39,28 +90,44 @@ func NewWindow(app fyne.App, config *config.AppConfig, timeManager *timezone.Man
 		config:      config,
 		timeManager: timeManager,
 		logger:      logger,
-		refreshRate: time.Duration(refreshRateSeconds) * time.Second,
+		refreshRate: refreshRate,
 		showSeconds: showSeconds,
 		ctx:         ctx,
 		cancel:      cancel,
 		statusBar:   widget.NewLabel(""),
+		collapsed:   make(map[string]bool),
 	}
 }
 
 func (w *Window) Show() {
 	w.window = w.app.NewWindow("MyTime")
+	w.setupTray()
 	w.setupUI()
 	w.startRefreshTimer()
+	w.startNotifications()
 	w.window.Show()
+	w.checkMissedAlarms()
 }
 
 func (w *Window) setupUI() {
+	w.updateRows()
+	w.updatePeopleRows()
 	w.table = w.createTimeTable()
+	people := w.createPeopleView()
+	events := w.createEventsView()
+	worldMap := w.createMapView()
+	zones := container.NewBorder(w.createTagFilter(), nil, nil, nil, w.table)
+	tabs := container.NewAppTabs(
+		container.NewTabItem("Zones", zones),
+		container.NewTabItem("People", people),
+		container.NewTabItem("Events", events),
+		container.NewTabItem("Map", worldMap),
+	)
 	content := container.NewBorder(
-		widget.NewLabel(""), // top
-		w.statusBar,         // bottom
-		nil, nil,            // left, right
-		w.table, // center
+		w.createTimeTravelBar(), // top
+		w.statusBar,             // bottom
+		nil, nil,                // left, right
+		tabs, // center
 	)
 
 	w.window.SetContent(content)
@@ -71,8 +138,7 @@ func (w *Window) setupUI() {
 func (w *Window) createTimeTable() *widget.Table {
 	table := widget.NewTable(
 		func() (int, int) {
-			timeInfo, _ := w.timeManager.GetTimeInfo()
-			return len(timeInfo) + 1, 5
+			return len(w.rows) + 1, 9
 		},
 		func() fyne.CanvasObject {
 			return widget.NewLabel("Template")
@@ -80,72 +146,193 @@ func (w *Window) createTimeTable() *widget.Table {
 		func(i widget.TableCellID, o fyne.CanvasObject) {
 			label := o.(*widget.Label)
 			if i.Row == 0 {
-				headers := []string{"Name", "Description", "Date", "Time", "HoursDiff"}
+				headers := []string{"Name", "Description", "Date", "Time", "HoursDiff", "Status", "Holiday", "Sun", "Next Offset Change"}
+				label.Importance = widget.MediumImportance
 				label.SetText(headers[i.Col])
 				return
 			}
 
-			timeInfo, err := w.timeManager.GetTimeInfo()
-			if err != nil {
-				w.logger.Error("Failed to get time info: %v", err)
+			if i.Row-1 >= len(w.rows) {
 				return
 			}
-
-			if i.Row-1 < len(timeInfo) {
-				info := timeInfo[i.Row-1]
-				switch i.Col {
-				case 0:
-					label.SetText(info.Name)
-				case 1:
-					label.SetText(info.Description)
-				case 2:
-					label.SetText(info.Date)
-				case 3:
-					loc, _ := time.LoadLocation(info.Name)
-					t := time.Now().In(loc)
-					var timeFormat string
-					if w.showSeconds {
-						timeFormat = "15:04:05"
-					} else {
-						timeFormat = "15:04"
+			row := w.rows[i.Row-1]
+			if row.isHeader() {
+				label.Importance = widget.HighImportance
+				label.SetText("")
+				if i.Col == 0 {
+					marker := "▼ "
+					if w.collapsed[row.group] {
+						marker = "▶ "
 					}
-					label.SetText(t.Format(timeFormat))
-				case 4:
-					label.SetText(info.Diff)
+					label.SetText(marker + row.headerText())
 				}
+				return
+			}
+
+			now := w.now()
+			info := *row.info
+			label.Importance = widget.MediumImportance
+			if w.diffChangesSoon(info, now) {
+				label.Importance = widget.WarningImportance
+			}
+			switch i.Col {
+			case 0:
+				label.SetText(info.Name)
+			case 1:
+				label.SetText(info.Description)
+			case 2:
+				label.SetText(info.Date)
+			case 3:
+				label.SetText(w.formatClock(info.Instant))
+			case 4:
+				label.SetText(info.Diff)
+			case 5:
+				label.SetText(info.Status)
+			case 6:
+				label.SetText(info.Holiday)
+			case 7:
+				label.SetText(formatSun(info))
+			case 8:
+				label.SetText(formatTransition(info))
 			}
 		},
 	)
 
+	// Clicking a group header collapses or expands it
+	table.OnSelected = func(id widget.TableCellID) {
+		table.UnselectAll()
+		w.toggleGroup(id.Row - 1)
+	}
+
 	// Set column widths
 	table.SetColumnWidth(0, 200)
 	table.SetColumnWidth(1, 200)
 	table.SetColumnWidth(2, 150)
 	table.SetColumnWidth(3, 120)
 	table.SetColumnWidth(4, 100)
+	table.SetColumnWidth(5, 120)
+	table.SetColumnWidth(6, 180)
+	table.SetColumnWidth(7, 200)
+	table.SetColumnWidth(8, 260)
 
 	return table
 }
 
+// formatClock formats a wall clock time, with seconds when configured
+func (w *Window) formatClock(t time.Time) string {
+	if w.showSeconds {
+		return t.Format("15:04:05")
+	}
+	return t.Format("15:04")
+}
+
+// diffChangesSoon reports whether the offset to Local changes within the
+// configured number of warning days
+func (w *Window) diffChangesSoon(info timezone.TimeInfo, now time.Time) bool {
+	if info.DiffChange == nil || w.config.DSTWarningDays <= 0 {
+		return false
+	}
+	return info.DiffChange.Within(now, time.Duration(w.config.DSTWarningDays)*24*time.Hour)
+}
+
+// formatSun shows the daylight phase and the next sunrise or sunset, such
+// as "Day, sunset in 1h20m"
+func formatSun(info timezone.TimeInfo) string {
+	if info.Daylight == "" {
+		return ""
+	}
+	text := strings.ToUpper(info.Daylight[:1]) + info.Daylight[1:]
+	if info.Sun != "" {
+		text += ", " + info.Sun
+	}
+	return text
+}
+
+func formatTransition(info timezone.TimeInfo) string {
+	var parts []string
+	if info.Transition != nil {
+		parts = append(parts, info.Transition.String())
+	}
+	if info.DiffChange != nil {
+		parts = append(parts, fmt.Sprintf("diff %s on %s", info.DiffChange.Offsets(), info.DiffChange.At.Format("2006-01-02")))
+	}
+	return strings.Join(parts, ", ")
+}
+
 func (w *Window) startRefreshTimer() {
+	w.ticker = time.NewTicker(w.refreshRate)
 	go func() {
-		ticker := time.NewTicker(w.refreshRate)
-		defer ticker.Stop()
+		defer w.ticker.Stop()
 
 		for {
 			select {
 			case <-w.ctx.Done():
 				return
-			case <-ticker.C:
-				w.refresh()
+			case <-w.ticker.C:
+				// The table callbacks read the rows on the UI thread
+				fyne.Do(w.refresh)
 			}
 		}
 	}()
 }
 
 func (w *Window) refresh() {
+	w.updateRows()
 	w.table.Refresh()
-	w.statusBar.SetText("Last updated: " + time.Now().Format("15:04:05"))
+	w.updatePeopleRows()
+	w.peopleList.Refresh()
+	w.refreshEvents()
+	w.refreshMap()
+	w.refreshTray()
+	status := "Last updated: " + time.Now().Format("15:04:05") + w.timeTravelStatus()
+	if w.configError != "" {
+		status += "  |  " + w.configError
+	} else if w.configWarnings != "" {
+		status += "  |  " + w.configWarnings
+	}
+	w.statusBar.SetText(status)
+}
+
+// ReloadConfig applies a config reloaded from disk. On error the last good
+// config stays active and the error is shown in the status bar. It is safe
+// to call from any goroutine.
+func (w *Window) ReloadConfig(cfg *config.AppConfig, err error) {
+	fyne.Do(func() {
+		if err != nil {
+			w.logger.Error("Config reload failed: %v", err)
+			w.configError = "Config not reloaded: " + err.Error()
+			w.refresh()
+			return
+		}
+		if err := w.timeManager.UpdateConfig(cfg.TimeZones); err != nil {
+			w.logger.Error("Config reload failed: %v", err)
+			_ = w.timeManager.UpdateConfig(w.config.TimeZones)
+			w.configError = "Config not reloaded: " + err.Error()
+			w.refresh()
+			return
+		}
+
+		if holidays, err := cfg.LoadHolidays(); err != nil {
+			w.logger.Error("%v", err)
+		} else {
+			w.timeManager.SetHolidays(holidays)
+		}
+
+		// Update in place, the edit windows share this config
+		*w.config = *cfg
+		w.showSeconds = cfg.ShowSeconds
+		if rate := cfg.RefreshRate(); rate != w.refreshRate {
+			w.refreshRate = rate
+			w.ticker.Reset(rate)
+		}
+		w.configError = ""
+		w.setConfigWarnings(cfg.Validate())
+		w.updateTravelZones()
+		w.updateTagFilter()
+		w.updateTrayMode()
+		w.logger.Info("Config reloaded")
+		w.refresh()
+	})
 }
 
 func (w *Window) setupMenu() {
@@ -156,8 +343,26 @@ func (w *Window) setupMenu() {
 				addWindow.Show()
 			}),
 			fyne.NewMenuItem("Edit Zones", w.showEditZonesWindow),
+			fyne.NewMenuItem("Import People…", w.showImportPeople),
+			fyne.NewMenuItem("Open Calendar…", w.showOpenCalendar),
+			fyne.NewMenuItem("Restore Previous Config…", w.showRestoreConfig),
+			w.trayModeMenuItem(),
 			fyne.NewMenuItem("Close", w.close),
 		),
+		fyne.NewMenu("Edit",
+			w.copyAsMenuItem(),
+		),
+		fyne.NewMenu("Tools",
+			fyne.NewMenuItem("Plan Meeting", w.showMeetingPlanner),
+			fyne.NewMenuItem("Export Meeting .ics…", func() {
+				// Start at the next half hour of the displayed time
+				start := w.now().Truncate(30 * time.Minute).Add(30 * time.Minute)
+				showExportICS(w.window, w.timeManager, w.travelZone.Selected, start, time.Hour)
+			}),
+			fyne.NewMenuItem("Notifications…", w.showNotifications),
+			fyne.NewMenuItem("Alarms…", w.showAlarms),
+			fyne.NewMenuItem("Validate Config", w.validateConfig),
+		),
 		fyne.NewMenu("Help",
 			fyne.NewMenuItem("About", w.showAbout),
 		),
@@ -165,6 +370,34 @@ func (w *Window) setupMenu() {
 	w.window.SetMainMenu(mainMenu)
 }
 
+func (w *Window) copyAsMenuItem() *fyne.MenuItem {
+	var items []*fyne.MenuItem
+	for _, format := range export.Formats {
+		items = append(items, fyne.NewMenuItem(strings.ToUpper(string(format)), func() {
+			w.copyAs(format)
+		}))
+	}
+	copyAs := fyne.NewMenuItem("Copy as…", nil)
+	copyAs.ChildMenu = fyne.NewMenu("", items...)
+	return copyAs
+}
+
+// copyAs puts the table, at the displayed instant, on the clipboard
+func (w *Window) copyAs(format export.Format) {
+	timeInfo, err := w.timeManager.GetTimeInfoAt(w.now())
+	if err != nil {
+		dialog.ShowError(err, w.window)
+		return
+	}
+	text, err := export.String(format, timeInfo)
+	if err != nil {
+		dialog.ShowError(err, w.window)
+		return
+	}
+	w.app.Clipboard().SetContent(text)
+	w.statusBar.SetText("Copied " + strings.ToUpper(string(format)) + " to clipboard")
+}
+
 func (w *Window) showTimeWindow() {
 	newWindow := w.app.NewWindow("Show Time")
 	newWindow.SetContent(w.createTimeTable())
@@ -179,6 +412,55 @@ func (w *Window) showEditZonesWindow() {
 	w.editWindow.Show()
 }
 
+func (w *Window) showMeetingPlanner() {
+	plannerWindow := NewMeetingPlannerWindow(w.app, w.timeManager)
+	plannerWindow.Show()
+}
+
+// ShowIssues lists every config problem in a single dialog
+func (w *Window) ShowIssues(issues timezone.Issues) {
+	title := "Configuration Warnings"
+	if issues.HasErrors() {
+		title = "Configuration Problems"
+	}
+	text := widget.NewLabel(issues.String())
+	text.Wrapping = fyne.TextWrapWord
+	content := container.NewVScroll(container.NewVBox(
+		widget.NewLabel(w.config.Path()),
+		text,
+	))
+	dlg := dialog.NewCustom(title, "Close", content, w.window)
+	dlg.Resize(fyne.NewSize(600, 400))
+	dlg.Show()
+}
+
+// ReportIssues shows the problems of the config found at startup: errors in
+// a dialog, warnings only in the status bar so a config with a deprecated
+// alias doesn't open a dialog on every start
+func (w *Window) ReportIssues(issues timezone.Issues) {
+	w.setConfigWarnings(issues)
+	if issues.Err() != nil {
+		w.ShowIssues(issues)
+	}
+}
+
+// setConfigWarnings counts the warnings of the config for the status bar
+func (w *Window) setConfigWarnings(issues timezone.Issues) {
+	w.configWarnings = ""
+	if n := len(issues); n > 0 && !issues.HasErrors() {
+		w.configWarnings = fmt.Sprintf("%d config warnings, see Tools > Validate Config", n)
+	}
+}
+
+func (w *Window) validateConfig() {
+	issues := w.config.Validate()
+	if len(issues) == 0 {
+		dialog.ShowInformation("Validate Config", "No problems found in "+w.config.Path(), w.window)
+		return
+	}
+	w.ShowIssues(issues)
+}
+
 func (w *Window) showAbout() {
 	dialog.ShowInformation("About", "MyTime - Time Zone Manager", w.window)
 }
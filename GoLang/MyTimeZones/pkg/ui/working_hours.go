@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+var weekdayOptions = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+// workingHoursEditor groups the widgets used to edit optional working hours
+type workingHoursEditor struct {
+	enabled *widget.Check
+	start   *widget.Entry
+	end     *widget.Entry
+	days    *widget.CheckGroup
+}
+
+func newWorkingHoursEditor() *workingHoursEditor {
+	h := &workingHoursEditor{
+		start: widget.NewEntry(),
+		end:   widget.NewEntry(),
+		days:  widget.NewCheckGroup(weekdayOptions, nil),
+	}
+	h.start.SetPlaceHolder("09:00")
+	h.end.SetPlaceHolder("17:00")
+	h.days.Horizontal = true
+	h.enabled = widget.NewCheck("Has working hours", h.setEnabled)
+	h.Set(nil)
+	return h
+}
+
+func (h *workingHoursEditor) setEnabled(enabled bool) {
+	if enabled {
+		h.start.Enable()
+		h.end.Enable()
+		h.days.Enable()
+	} else {
+		h.start.Disable()
+		h.end.Disable()
+		h.days.Disable()
+	}
+}
+
+// Set loads hours into the widgets, nil clears and disables them
+func (h *workingHoursEditor) Set(hours *timezone.WorkingHours) {
+	if hours == nil {
+		h.enabled.SetChecked(false)
+		h.start.SetText(timezone.DefaultWorkingHours.Start)
+		h.end.SetText(timezone.DefaultWorkingHours.End)
+		h.days.SetSelected(timezone.DefaultWorkingHours.Days)
+		h.setEnabled(false)
+		return
+	}
+	h.enabled.SetChecked(true)
+	h.start.SetText(hours.Start)
+	h.end.SetText(hours.End)
+	h.days.SetSelected(hours.Days)
+	h.setEnabled(true)
+}
+
+// Get returns the edited hours, or nil when working hours are disabled
+func (h *workingHoursEditor) Get() (*timezone.WorkingHours, error) {
+	if !h.enabled.Checked {
+		return nil, nil
+	}
+	hours := &timezone.WorkingHours{
+		Start: strings.TrimSpace(h.start.Text),
+		End:   strings.TrimSpace(h.end.Text),
+	}
+	// Keep the days in week order rather than click order
+	for _, day := range weekdayOptions {
+		for _, selected := range h.days.Selected {
+			if selected == day {
+				hours.Days = append(hours.Days, day)
+			}
+		}
+	}
+	// Hours with no days would mean every day, not none
+	if len(hours.Days) == 0 {
+		return nil, errors.New("select at least one work day")
+	}
+	if err := hours.Validate(); err != nil {
+		return nil, err
+	}
+	return hours, nil
+}
+
+// FormItems returns the form rows for the editor
+func (h *workingHoursEditor) FormItems() []*widget.FormItem {
+	return []*widget.FormItem{
+		widget.NewFormItem("", h.enabled),
+		widget.NewFormItem("Work Starts", h.start),
+		widget.NewFormItem("Work Ends", h.end),
+		widget.NewFormItem("Work Days", h.days),
+	}
+}
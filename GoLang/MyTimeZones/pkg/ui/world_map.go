@@ -0,0 +1,182 @@
+package ui
+
+//go:generate go run ../../mkworldmap.go -o world.png
+
+import (
+	_ "embed"
+	"image/color"
+	"slices"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/yourusername/MyTimeZones/pkg/solar"
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+//go:embed world.png
+var worldPNG []byte
+
+// nightAlpha is the shade of the map where the sun is more than 6 degrees
+// below the horizon; civil twilight fades between it and full daylight
+const nightAlpha = 150
+
+// worldMap is the map tab: an equirectangular world map with the night
+// side shaded and a marker for every configured zone with coordinates
+type worldMap struct {
+	image   *canvas.Image
+	night   *canvas.Raster
+	content *fyne.Container
+	markers []mapMarker
+
+	// Subsolar point of the shaded instant, moved once a minute
+	shadedAt       time.Time
+	subLat, subLon float64
+}
+
+// mapMarker is a zone's dot and its label with the local time
+type mapMarker struct {
+	place timezone.Coordinates
+	dot   *canvas.Circle
+	label *canvas.Text
+}
+
+func (w *Window) createMapView() fyne.CanvasObject {
+	m := &worldMap{}
+	m.image = canvas.NewImageFromResource(fyne.NewStaticResource("world.png", worldPNG))
+	m.image.FillMode = canvas.ImageFillStretch
+	m.night = canvas.NewRasterWithPixels(m.shade)
+	m.content = container.New(m, m.image, m.night)
+	w.worldMap = m
+	w.refreshMap()
+	return m.content
+}
+
+// refreshMap moves the terminator and relabels the markers for the current
+// or time travel instant
+func (w *Window) refreshMap() {
+	m := w.worldMap
+	if m == nil {
+		return
+	}
+	at := w.now()
+
+	if minute := at.Truncate(time.Minute); !minute.Equal(m.shadedAt) {
+		m.shadedAt = minute
+		m.subLat, m.subLon = solar.SubsolarPoint(minute)
+		m.night.Refresh()
+	}
+
+	timeInfo, err := w.timeManager.GetTimeInfoAt(at)
+	if err != nil {
+		w.logger.Error("Failed to get time info: %v", err)
+		return
+	}
+	// timeInfo follows the entries, Local first, so a zone listed twice with
+	// its own coordinates is placed by position rather than by name
+	tzConfig := w.timeManager.GetConfig()
+	entries := append([]timezone.TimeZoneEntry{tzConfig.Local}, tzConfig.Others...)
+	if len(entries) != len(timeInfo) {
+		return
+	}
+	var places []timezone.Coordinates
+	var labels []string
+	for n, info := range timeInfo {
+		place := entries[n].Place()
+		if place == nil {
+			continue
+		}
+		places = append(places, *place)
+		labels = append(labels, info.Description+" "+w.formatClock(info.Instant))
+	}
+
+	if !slices.EqualFunc(m.markers, places, func(marker mapMarker, place timezone.Coordinates) bool {
+		return marker.place == place
+	}) {
+		m.setMarkers(places, labels)
+		return
+	}
+	for n, marker := range m.markers {
+		if marker.label.Text != labels[n] {
+			marker.label.Text = labels[n]
+			marker.label.Refresh()
+		}
+	}
+	// Labels change width with the time, place them again without
+	// redrawing the map
+	m.Layout(nil, m.content.Size())
+}
+
+// setMarkers replaces the markers, only when the zones change
+func (m *worldMap) setMarkers(places []timezone.Coordinates, labels []string) {
+	m.markers = make([]mapMarker, len(places))
+	objects := []fyne.CanvasObject{m.image, m.night}
+	for n, place := range places {
+		dot := canvas.NewCircle(theme.Color(theme.ColorNamePrimary))
+		dot.StrokeColor = color.White
+		dot.StrokeWidth = 1
+		label := canvas.NewText(labels[n], color.White)
+		label.TextSize = theme.CaptionTextSize()
+		m.markers[n] = mapMarker{place: place, dot: dot, label: label}
+		objects = append(objects, dot, label)
+	}
+	m.content.Objects = objects
+	m.content.Refresh()
+}
+
+// shade returns the night shading of a pixel of the map
+func (m *worldMap) shade(x, y, width, height int) color.Color {
+	latitude := 90 - (float64(y)+0.5)/float64(height)*180
+	longitude := (float64(x)+0.5)/float64(width)*360 - 180
+	elevation := solar.ElevationFrom(m.subLat, m.subLon, latitude, longitude)
+	switch {
+	case elevation >= 0:
+		return color.Transparent
+	case elevation <= -6:
+		return color.NRGBA{A: nightAlpha}
+	default:
+		return color.NRGBA{A: uint8(-elevation / 6 * nightAlpha)}
+	}
+}
+
+// mapArea returns the largest 2:1 rectangle centered in size
+func mapArea(size fyne.Size) (fyne.Position, fyne.Size) {
+	width, height := size.Width, size.Width/2
+	if height > size.Height {
+		width, height = size.Height*2, size.Height
+	}
+	return fyne.NewPos((size.Width-width)/2, (size.Height-height)/2), fyne.NewSize(width, height)
+}
+
+// Layout places the map and night shade in the map area and each marker at
+// its zone's coordinates
+func (m *worldMap) Layout(_ []fyne.CanvasObject, size fyne.Size) {
+	origin, area := mapArea(size)
+	m.image.Move(origin)
+	m.image.Resize(area)
+	m.night.Move(origin)
+	m.night.Resize(area)
+
+	const dotSize = 8
+	for _, marker := range m.markers {
+		x := origin.X + float32((marker.place.Longitude+180)/360)*area.Width
+		y := origin.Y + float32((90-marker.place.Latitude)/180)*area.Height
+		marker.dot.Resize(fyne.NewSize(dotSize, dotSize))
+		marker.dot.Move(fyne.NewPos(x-dotSize/2, y-dotSize/2))
+		labelSize := marker.label.MinSize()
+		marker.label.Resize(labelSize)
+		labelX := x + dotSize
+		if labelX+labelSize.Width > origin.X+area.Width {
+			labelX = x - dotSize - labelSize.Width
+		}
+		marker.label.Move(fyne.NewPos(labelX, y-labelSize.Height/2))
+	}
+}
+
+// MinSize is small enough for the map to share the window with the tabs
+func (m *worldMap) MinSize(_ []fyne.CanvasObject) fyne.Size {
+	return fyne.NewSize(360, 180)
+}
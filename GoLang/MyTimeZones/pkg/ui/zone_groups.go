@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/yourusername/MyTimeZones/pkg/timezone"
+)
+
+const allTags = "All tags"
+
+// tableRow is one row of the main table: a group header or a zone
+type tableRow struct {
+	group string
+	count int
+	info  *timezone.TimeInfo
+}
+
+func (r tableRow) isHeader() bool {
+	return r.info == nil
+}
+
+// updateRows rebuilds the table rows at the displayed instant. Ungrouped
+// zones come first, then one collapsible section per group. The Local zone
+// is always shown, other zones only when they match the tag filter.
+func (w *Window) updateRows() {
+	timeInfo, err := w.timeManager.GetTimeInfoAt(w.now())
+	if err != nil {
+		w.logger.Error("Failed to get time info: %v", err)
+		w.rows = nil
+		return
+	}
+
+	var ungrouped []tableRow
+	grouped := make(map[string][]tableRow)
+	var groups []string
+	for n := range timeInfo {
+		info := &timeInfo[n]
+		if n > 0 && w.tagFilter != "" && !slices.ContainsFunc(info.Tags, func(t string) bool {
+			return strings.EqualFold(t, w.tagFilter)
+		}) {
+			continue
+		}
+		if info.Group == "" {
+			ungrouped = append(ungrouped, tableRow{info: info})
+			continue
+		}
+		if _, ok := grouped[info.Group]; !ok {
+			groups = append(groups, info.Group)
+		}
+		grouped[info.Group] = append(grouped[info.Group], tableRow{group: info.Group, info: info})
+	}
+
+	rows := ungrouped
+	for _, group := range groups {
+		rows = append(rows, tableRow{group: group, count: len(grouped[group])})
+		if !w.collapsed[group] {
+			rows = append(rows, grouped[group]...)
+		}
+	}
+	w.rows = rows
+}
+
+func (r tableRow) headerText() string {
+	return fmt.Sprintf("%s (%d)", r.group, r.count)
+}
+
+// toggleGroup collapses or expands the group of a header row
+func (w *Window) toggleGroup(row int) {
+	if row < 0 || row >= len(w.rows) || !w.rows[row].isHeader() {
+		return
+	}
+	group := w.rows[row].group
+	w.collapsed[group] = !w.collapsed[group]
+	w.refresh()
+}
+
+func (w *Window) createTagFilter() fyne.CanvasObject {
+	w.tagSelect = widget.NewSelect(nil, func(tag string) {
+		if tag == allTags {
+			tag = ""
+		}
+		w.tagFilter = tag
+		w.refresh()
+	})
+	w.updateTagFilter()
+	return container.NewHBox(widget.NewLabel("Tag"), w.tagSelect)
+}
+
+// updateTagFilter offers the configured tags, dropping a filter on a tag
+// that no longer exists
+func (w *Window) updateTagFilter() {
+	tags := w.timeManager.GetConfig().Tags()
+	w.tagSelect.Options = append([]string{allTags}, tags...)
+	if w.tagFilter == "" || !slices.Contains(tags, w.tagFilter) {
+		w.tagFilter = ""
+		w.tagSelect.SetSelected(allTags)
+	}
+	w.tagSelect.Refresh()
+}
+
+// parseTags splits a comma separated list of tags
+func parseTags(text string) []string {
+	var tags []string
+	for _, t := range strings.Split(text, ",") {
+		if t = strings.TrimSpace(t); t != "" && !slices.Contains(tags, t) {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}